@@ -0,0 +1,31 @@
+package linode
+
+import "context"
+
+const (
+	accountInfoAction = "account.info"
+)
+
+// AccountInfo returns the Account associated with the API key
+func (c *Client) AccountInfo() (*Account, error) {
+	return c.AccountInfoContext(context.Background())
+}
+
+// AccountInfoContext is like AccountInfo, with ctx to cancel the API requests
+func (c *Client) AccountInfoContext(ctx context.Context) (*Account, error) {
+	var account Account
+	if err := c.doActionContext(ctx, accountInfoAction, nil, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// Account represents the account info as returned by the API
+type Account struct {
+	TransferPool     int        `json:"TRANSFER_POOL"`
+	TransferUsed     int        `json:"TRANSFER_USED"`
+	TransferBillable int        `json:"TRANSFER_BILLABLE"`
+	Managed          bool       `json:"MANAGED"`
+	Balance          float64    `json:"BALANCE"`
+	ActiveSince      LinodeTime `json:"ACTIVE_SINCE"`
+}
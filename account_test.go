@@ -0,0 +1,39 @@
+package linode
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAccountInfo(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"TRANSFER_POOL":2000,"TRANSFER_USED":1,"TRANSFER_BILLABLE":0,"MANAGED":true,"BALANCE":0.00,"ACTIVE_SINCE":"2001-09-06 00:00:00.0"},"ACTION":"account.info"}]`)
+	defer server.Close()
+
+	account, err := c.AccountInfo()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"api_action":"account.info"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := Account{
+		TransferPool: 2000,
+		TransferUsed: 1,
+		Managed:      true,
+		ActiveSince:  LinodeTime{time.Date(2001, 9, 6, 0, 0, 0, 0, time.UTC)},
+	}
+	if *account != expected {
+		t.Error("expected", expected, "given", *account)
+	}
+}
+
+func TestAccountInfoResponseCount(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{"TRANSFER_POOL":2000},"ACTION":"account.info"},{"ERRORARRAY":[],"DATA":{"TRANSFER_POOL":4000},"ACTION":"account.info"}]`)
+	defer server.Close()
+
+	if _, err := c.AccountInfo(); !errors.Is(err, ErrUnexpectedResponseCount) {
+		t.Error("expected", ErrUnexpectedResponseCount, "given", err)
+	}
+}
@@ -2,17 +2,33 @@
 package linode
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"mime"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
 )
 
 const (
 	apiEndpoint      = "https://api.linode.com/"
-	maxBatchRequests = 24 // undocumented in Linode API docs
+	maxBatchRequests = 24   // undocumented in Linode API docs
+	maxURLLength     = 4096 // longer URLs are sent as POST requests, see newHTTPRequest
+	defaultUserAgent = "awilliams-linode-go/1.0"
+	maxErrorSnippet  = 512 // max number of body bytes included in decode errors
+	apiKeyEnv        = "LINODE_API_KEY"
+	maxRetryDelay    = time.Minute // upper bound of the doubled retry delay, see backoff
 )
 
 var apiEndpointURL *url.URL
@@ -23,13 +39,264 @@ func init() {
 
 // NewClient creates a client instance which can be used to craft
 // HTTP requests and parse JSON responses from the Linode API.
-func NewClient(apiKey string) *Client {
-	return &Client{apiKey: apiKey}
+// Options are applied in the order given.
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:      apiKey,
+		httpClient:  http.DefaultClient,
+		baseURL:     apiEndpointURL,
+		concurrency: 1,
+		batchLimit:  maxBatchRequests,
+		userAgent:   defaultUserAgent,
+		logger:      noopLogger{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.timeout > 0 {
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
+	}
+	return c
 }
 
-// Client used to make API requests
+// NewClientFromEnv is like NewClient, but reads the API key from the LINODE_API_KEY environment variable.
+// An error is returned if the variable is unset or empty.
+func NewClientFromEnv(opts ...Option) (*Client, error) {
+	apiKey := os.Getenv(apiKeyEnv)
+	if apiKey == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", apiKeyEnv)
+	}
+	return NewClient(apiKey, opts...), nil
+}
+
+// NewClientFromFile is like NewClient, but reads the API key from the file at path, such as ~/.linode.
+// The key is the first line which is not empty once trimmed. An error is returned if the file can not be read
+// or contains no key.
+func NewClientFromFile(path string, opts ...Option) (*Client, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read API key: %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if apiKey := strings.TrimSpace(line); apiKey != "" {
+			return NewClient(apiKey, opts...), nil
+		}
+	}
+	return nil, fmt.Errorf("API key file %s is empty", path)
+}
+
+// Client used to make API requests.
+// A Client is not modified after its creation, and is safe for concurrent use by multiple goroutines,
+// as are its HTTP client and rate limiter. A Request however must not be used concurrently;
+// instead each goroutine should create its own Request with NewRequest.
 type Client struct {
-	apiKey string
+	apiKey     string
+	httpClient *http.Client
+	timeout    time.Duration // applied to a copy of httpClient by NewClient, see WithTimeout
+	baseURL    *url.URL
+	userAgent  string
+	logger     Logger
+	pretty     bool // log the indented api_requestArray, see WithPrettyRequestArray
+	metrics    MetricsFunc
+	inspector  func(*http.Response)
+	// retry configuration, see WithRetry
+	maxAttempts int
+	retryDelay  time.Duration
+	batchLimit  int           // max number of actions per batch url, see WithBatchLimit
+	maxBatches  int           // max number of batch urls per Request, 0 if unlimited, see WithMaxBatches
+	maxActions  int           // max number of actions per Request, 0 if unlimited, see WithActionBudget
+	concurrency int           // max number of concurrent HTTP requests per Request, see WithConcurrency
+	failFast    bool          // stop fetching batch URLs after the first failed one, see WithFailFast
+	limiter     *rate.Limiter // shared by all requests of the client, see WithRateLimit
+	err         error         // deferred configuration error, returned when building requests
+	postOnly    bool          // always send requests as form POST, to keep credentials out of URLs
+}
+
+// Logger is used to log debug information, such as outgoing requests and response statuses.
+// It is satisfied by *log.Logger. A Logger may be called concurrently, so it must be safe for concurrent use.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(format string, args ...interface{}) {}
+
+// String returns a description of the client, without its API key
+func (c Client) String() string {
+	return fmt.Sprintf("linode.Client{api_key: ***, baseURL: %s}", c.baseURL)
+}
+
+// Option configures a Client. See NewClient.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to perform API requests. Defaults to http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		if hc != nil {
+			c.httpClient = hc
+		}
+	}
+}
+
+// WithBaseURL sets the API endpoint requests are made against. Defaults to https://api.linode.com/.
+// rawurl must be an absolute http or https URL. If it is not, the error is returned by any subsequent request.
+func WithBaseURL(rawurl string) Option {
+	return func(c *Client) {
+		u, err := parseBaseURL(rawurl)
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.baseURL = u
+	}
+}
+
+func parseBaseURL(rawurl string) (*url.URL, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: must be an absolute http(s) URL", rawurl)
+	}
+	return u, nil
+}
+
+// WithRetry retries HTTP requests which fail with a network error or a 5xx status code, up to maxAttempts attempts in total.
+// The delay between attempts starts at baseDelay and doubles after each attempt, up to a minute, with added jitter.
+// Errors reported by the API in a successful response are never retried.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Client) {
+		c.maxAttempts = maxAttempts
+		c.retryDelay = baseDelay
+	}
+}
+
+// WithBatchLimit sets the maximum number of actions batched into a single HTTP request. Defaults to 24.
+// n must be greater than 0, otherwise an error is returned by any subsequent request.
+func WithBatchLimit(n int) Option {
+	return func(c *Client) {
+		if n < 1 {
+			c.err = fmt.Errorf("invalid batch limit %d: must be greater than 0", n)
+			return
+		}
+		c.batchLimit = n
+	}
+}
+
+// WithMaxBatches limits the number of batch URLs of a single Request to n, as a safety valve against
+// accidentally queuing thousands of actions. If a Request needs more batches, an error is returned by URLs
+// and nothing is fetched. By default the number of batches is unlimited.
+// n must be greater than 0, otherwise an error is returned by any subsequent request.
+func WithMaxBatches(n int) Option {
+	return func(c *Client) {
+		if n < 1 {
+			c.err = fmt.Errorf("invalid max batches %d: must be greater than 0", n)
+			return
+		}
+		c.maxBatches = n
+	}
+}
+
+// WithActionBudget limits the number of actions of a single Request to n, to prevent exhausting the API quota
+// by surprise. If a Request has more actions, an error is returned by URLs, and so by GetJSON, and nothing is fetched.
+// By default the number of actions is unlimited.
+// n must be greater than 0, otherwise an error is returned by any subsequent request.
+func WithActionBudget(n int) Option {
+	return func(c *Client) {
+		if n < 1 {
+			c.err = fmt.Errorf("invalid action budget %d: must be greater than 0", n)
+			return
+		}
+		c.maxActions = n
+	}
+}
+
+// WithConcurrency sets the maximum number of batch URLs of a single Request which are fetched concurrently. Defaults to 1.
+// Values less than 1 are ignored.
+func WithConcurrency(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// WithFailFast makes GetJSON and Stream stop after the first batch URL which fails with a request or API error,
+// cancelling the fetches still in progress, and return only the errors of that batch.
+// The responses received up to that point are still returned by GetJSON.
+func WithFailFast() Option {
+	return func(c *Client) {
+		c.failFast = true
+	}
+}
+
+// WithRateLimit limits the rate of HTTP requests made by the client to r per second, allowing bursts of up to burst requests.
+// The limit is shared by all requests created from the client.
+func WithRateLimit(r rate.Limit, burst int) Option {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(r, burst)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each HTTP request. Defaults to "awilliams-linode-go/1.0".
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithLogger sets the Logger used to log each outgoing request URL, with the API key redacted, and its response status.
+// By default nothing is logged.
+func WithLogger(l Logger) Option {
+	return func(c *Client) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// MetricsFunc is called after each HTTP request with the request URL, with the API key redacted,
+// the response status code, the time until the response headers were received, and the request error, if any.
+// status is 0 if the request failed. A MetricsFunc may be called concurrently.
+type MetricsFunc func(url string, status int, dur time.Duration, err error)
+
+// WithMetrics sets a callback to record the latency and status of each HTTP request, including retries.
+// By default no callback is set.
+func WithMetrics(fn MetricsFunc) Option {
+	return func(c *Client) {
+		c.metrics = fn
+	}
+}
+
+// WithResponseInspector sets a callback which is called with each HTTP response of the last attempt,
+// before its body is read, e.g. to read rate limit headers. fn must not read or close the body,
+// and may be called concurrently. By default no callback is set.
+func WithResponseInspector(fn func(*http.Response)) Option {
+	return func(c *Client) {
+		c.inspector = fn
+	}
+}
+
+// WithPrettyRequestArray is a debugging aid which additionally logs the api_requestArray of each request
+// as indented JSON to the Logger set with WithLogger. The compact JSON is still sent to the API,
+// and returned by URLs.
+func WithPrettyRequestArray() Option {
+	return func(c *Client) {
+		c.pretty = true
+	}
+}
+
+// WithTimeout sets a time limit for each HTTP request made by the client.
+// It is applied after all other options, to a copy of the HTTP client, so a client
+// given via WithHTTPClient is not modified.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
 }
 
 // NewRequest creates a Request object
@@ -38,38 +305,138 @@ func (c *Client) NewRequest() *Request {
 }
 
 // Request holds one or multiple API requests, which will be batched together when calling GetJSON.
+// A Request keeps no responses or errors between calls, so it can be fetched again, e.g. to retry after an error.
 type Request struct {
 	client  Client
 	actions []action
+	tags    []string // tag of each action, see AddTaggedAction
 }
 
 type action map[string]string
 
 // AddAction adds an API action to the request. method arg corresponds to the 'api_action' parameter. Returns r for chainability.
 func (r *Request) AddAction(method string, params map[string]string) *Request {
-	var a action
-	if params == nil {
-		a = make(action)
-	} else {
-		a = action(params)
+	// copy params, so the caller's map is not modified
+	a := make(action, len(params)+1)
+	for k, v := range params {
+		a[k] = v
 	}
 	a["api_action"] = method
 	r.actions = append(r.actions, a)
+	r.tags = append(r.tags, "")
 	return r
 }
 
-// URLs returns a slice of urls which hold the created actions and their params. Multiple urls may be returned if the batch limit is reached.
-func (r *Request) URLs() ([]string, error) {
+// AddTaggedAction is like AddAction, but tags the action so that its response can be found with GetJSONTagged.
+// This is useful when the same method is added multiple times. Returns r for chainability.
+func (r *Request) AddTaggedAction(tag, method string, params map[string]string) *Request {
+	r.AddAction(method, params)
+	r.tags[len(r.tags)-1] = tag
+	return r
+}
+
+// AddActionValues is like AddAction, but accepts params of any type. Bools are formatted as 1 or 0,
+// other values are formatted with fmt.Sprint. Returns r for chainability.
+func (r *Request) AddActionValues(method string, params map[string]interface{}) *Request {
+	a := make(map[string]string, len(params))
+	for k, v := range params {
+		switch v := v.(type) {
+		case string:
+			a[k] = v
+		case bool:
+			a[k] = boolParam(v)
+		case int:
+			a[k] = strconv.Itoa(v)
+		default:
+			a[k] = fmt.Sprint(v)
+		}
+	}
+	return r.AddAction(method, a)
+}
+
+// Reset removes all actions from the request, so it can be reused. Returns r for chainability.
+func (r *Request) Reset() *Request {
+	r.actions = r.actions[:0]
+	r.tags = r.tags[:0]
+	return r
+}
+
+// Dedupe removes actions which exactly duplicate a previous action, i.e. with the same method and params,
+// preserving the order of the remaining actions. Actions with different tags are not considered duplicates,
+// so that GetJSONTagged still finds each tag. Returns r for chainability.
+func (r *Request) Dedupe() *Request {
+	seen := make(map[string]bool, len(r.actions))
+	actions, tags := r.actions[:0], r.tags[:0]
+	for i, a := range r.actions {
+		key, _ := json.Marshal(a) // map keys are sorted, so equal actions marshal identically
+		k := r.tags[i] + "\x00" + string(key)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		actions = append(actions, a)
+		tags = append(tags, r.tags[i])
+	}
+	r.actions, r.tags = actions, tags
+	return r
+}
+
+// WithAPIKey overrides the API key of the client for the URLs of r, e.g. to access several accounts with one Client.
+// Returns r for chainability.
+func (r *Request) WithAPIKey(key string) *Request {
+	r.client.apiKey = key
+	return r
+}
+
+// ActionCount returns the number of actions added to the request
+func (r *Request) ActionCount() int {
+	return len(r.actions)
+}
+
+// EstimatedActions returns the number of actions the request counts against the API quota.
+// Each action counts, regardless of how the actions are batched into HTTP requests.
+func (r *Request) EstimatedActions() int {
+	return r.ActionCount()
+}
+
+// BatchCount returns the number of batch urls, and so HTTP requests, needed for the actions of the request
+func (r *Request) BatchCount() int {
 	numActions := len(r.actions)
 	if numActions == 0 {
+		return 0
+	}
+	limit := r.batchLimit()
+	return (numActions + limit - 1) / limit
+}
+
+// batchLimit returns the max number of actions per batch url
+func (r *Request) batchLimit() int {
+	if r.client.batchLimit < 1 {
+		return maxBatchRequests
+	}
+	return r.client.batchLimit
+}
+
+// URLs returns a slice of urls which hold the created actions and their params. Multiple urls may be returned if the batch limit is reached.
+func (r *Request) URLs() ([]string, error) {
+	if r.client.err != nil {
+		return nil, r.client.err
+	}
+	numBatches := r.BatchCount()
+	if numBatches == 0 {
 		return []string{}, nil
 	}
+	if r.client.maxActions > 0 && len(r.actions) > r.client.maxActions {
+		return nil, fmt.Errorf("action budget exceeded: %d actions, the budget is %d", len(r.actions), r.client.maxActions)
+	}
+	if r.client.maxBatches > 0 && numBatches > r.client.maxBatches {
+		return nil, fmt.Errorf("too many batches: %d actions need %d batches, the limit is %d", len(r.actions), numBatches, r.client.maxBatches)
+	}
 	// divide the actions into groups which respect the max number of batch actions
-	numerator := maxBatchRequests + 1
-	numBatches := (numActions / numerator) + 1
+	limit := r.batchLimit()
 	actionBatches := make([][]action, numBatches)
 	for i, action := range r.actions {
-		j := i / numerator
+		j := i / limit
 		actionBatches[j] = append(actionBatches[j], action)
 	}
 
@@ -77,79 +444,525 @@ func (r *Request) URLs() ([]string, error) {
 	urls := make([]string, len(actionBatches))
 	for i, actions := range actionBatches {
 		params := make(url.Values)
-		params.Set("api_key", r.client.apiKey)
+		if r.client.apiKey != "" {
+			params.Set("api_key", r.client.apiKey)
+		}
 		params.Set("api_action", "batch")
+		params.Set("api_responseFormat", "json")
 		requestArrayValue, err := json.Marshal(actions)
 		if err != nil {
 			return nil, err
 		}
 		params.Set("api_requestArray", string(requestArrayValue))
-		u := apiEndpointURL // make a copy of the base URL
+		u := *r.client.baseURL // make a copy of the base URL
 		u.RawQuery = params.Encode()
 		urls[i] = u.String()
 	}
 	return urls, nil
 }
 
+// RedactedURLs is like URLs, but the API key is replaced by "***", so the urls can be safely logged
+func (r *Request) RedactedURLs() ([]string, error) {
+	urls, err := r.URLs()
+	if err != nil {
+		return nil, err
+	}
+	for i, u := range urls {
+		urls[i] = redactURL(u)
+	}
+	return urls, nil
+}
+
+// String returns a description of the request's actions, without its API key or credentials
+func (r Request) String() string {
+	actions, err := json.Marshal(redactActions(r.actions))
+	if err != nil {
+		actions = []byte("?")
+	}
+	return fmt.Sprintf("linode.Request{api_key: ***, actions: %s}", actions)
+}
+
 // Response contains the 'ACTION' and raw 'DATA' params included in a batch response
 type Response struct {
 	Action string
 	Data   json.RawMessage
 }
 
-// GetJSON performs one or more HTTP GET requests and returns a slice of Response objects and possible error
+// GetJSON performs one or more HTTP requests and returns a slice of Response objects and possible error.
+// If every error is reported by the API itself, the error is of type APIErrors.
+// Responses of successful actions are returned even when err is non-nil, so callers must check both return values.
 func (r *Request) GetJSON() ([]Response, error) {
+	return r.GetJSONContext(context.Background())
+}
+
+// GetJSONContext is like GetJSON, but each HTTP request is bound to ctx. Once ctx is done, no further batch URLs are requested.
+// Batch URLs longer than 4KB are sent as a form-encoded POST request instead of a GET request.
+func (r *Request) GetJSONContext(ctx context.Context) ([]Response, error) {
+	responses, _, err := r.getJSON(ctx)
+	return responses, err
+}
+
+// getJSON implements GetJSONContext, and also returns the index within r of the action of each response
+func (r *Request) getJSON(ctx context.Context) ([]Response, []int, error) {
 	var responses []Response
+	var indexes []int
 	var errs []error
 
 	urls, err := r.URLs()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// each batch url is fetched by its own goroutine, bounded by the client's concurrency.
+	// Results are indexed by url to preserve the order of responses.
+	type batchResult struct {
+		responses []Response
+		indexes   []int
+		errs      []error
 	}
-	for _, u := range urls {
-		responses, errs = getJSON(u, responses, errs)
+	results := make([]batchResult, len(urls))
+	concurrency := r.client.concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	// fetchCtx is cancelled by the first failed batch if the client fails fast
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var failOnce sync.Once
+	failed := -1
+	var wg sync.WaitGroup
+	for i, u := range urls {
+		sem <- struct{}{}
+		if fetchCtx.Err() != nil {
+			err = ctx.Err()
+			break
+		}
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].errs, _ = r.client.streamJSON(fetchCtx, u, i*r.batchLimit(), func(index int, resp Response) error {
+				results[i].responses = append(results[i].responses, resp)
+				results[i].indexes = append(results[i].indexes, index)
+				return nil
+			}, nil)
+			if r.client.failFast && len(results[i].errs) > 0 {
+				failOnce.Do(func() {
+					failed = i
+					cancel()
+				})
+			}
+		}(i, u)
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		responses = append(responses, result.responses...)
+		indexes = append(indexes, result.indexes...)
+		if failed < 0 || i == failed {
+			errs = append(errs, result.errs...)
+		}
+	}
+	if err != nil {
+		errs = append(errs, err)
 	}
 	if len(errs) > 0 {
-		errStrings := make([]string, len(errs))
-		for i, err := range errs {
-			errStrings[i] = err.Error()
+		return responses, indexes, joinErrors(errs)
+	}
+	return responses, indexes, nil
+}
+
+// GetJSONTagged is like GetJSON, but returns a mapping of tag to Response for the actions added with AddTaggedAction.
+// Responses of untagged actions are not included.
+func (r *Request) GetJSONTagged() (map[string]Response, error) {
+	responses, indexes, err := r.getJSON(context.Background())
+	tagged := make(map[string]Response)
+	for i, resp := range responses {
+		if index := indexes[i]; index < len(r.tags) && r.tags[index] != "" {
+			tagged[r.tags[index]] = resp
+		}
+	}
+	return tagged, err
+}
+
+// Stream performs the HTTP requests of r one at a time, and calls fn for each successful Response as it is decoded,
+// so that large result sets are not buffered in memory. If fn returns an error, Stream stops and returns it.
+// Otherwise, request and API errors are returned once all batch URLs are done, like GetJSON.
+func (r *Request) Stream(ctx context.Context, fn func(Response) error) error {
+	urls, err := r.URLs()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i, u := range urls {
+		if err = ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		errs, err = r.client.streamJSON(ctx, u, i*r.batchLimit(), func(_ int, resp Response) error {
+			return fn(resp)
+		}, errs)
+		if err != nil {
+			return err
+		}
+		if r.client.failFast && len(errs) > 0 {
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return joinErrors(errs)
+	}
+	return nil
+}
+
+// joinErrors returns APIErrors if all errs are API errors, otherwise an error joining all messages.
+// In both cases, the individual errors can be matched with errors.Is and errors.As.
+func joinErrors(errs []error) error {
+	apiErrs := make(APIErrors, 0, len(errs))
+	for _, err := range errs {
+		if apiErr, ok := err.(APIError); ok {
+			apiErrs = append(apiErrs, apiErr)
 		}
-		return nil, errors.New(strings.Join(errStrings, "; "))
 	}
-	return responses, nil
+	if len(apiErrs) == len(errs) {
+		return apiErrs
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return multiError(errs)
+}
+
+// multiError holds multiple errors of GetJSON, of which some are not API errors
+type multiError []error
+
+func (errs multiError) Error() string {
+	errStrings := make([]string, len(errs))
+	for i, err := range errs {
+		errStrings[i] = err.Error()
+	}
+	return strings.Join(errStrings, "; ")
+}
+
+// Unwrap allows errors.Is and errors.As to match any of the errors
+func (errs multiError) Unwrap() []error {
+	return errs
+}
+
+// APIError is an error reported by the Linode API in a response's 'ERRORARRAY'
+type APIError struct {
+	Code    int
+	Message string
+	Action  string
+}
+
+func (e APIError) Error() string {
+	return fmt.Sprintf("[code: %d] %s", e.Code, e.Message)
+}
+
+// APIErrors is returned by GetJSON when one or more actions failed with an API error.
+// errors.As with a *APIError target extracts the first APIError.
+type APIErrors []APIError
+
+func (errs APIErrors) Error() string {
+	errStrings := make([]string, len(errs))
+	for i, err := range errs {
+		errStrings[i] = err.Error()
+	}
+	return strings.Join(errStrings, "; ")
 }
 
-func getJSON(u string, responses []Response, errs []error) ([]Response, []error) {
-	resp, err := http.Get(u)
+// Unwrap allows errors.Is and errors.As to match any of the API errors
+func (errs APIErrors) Unwrap() []error {
+	unwrapped := make([]error, len(errs))
+	for i, err := range errs {
+		unwrapped[i] = err
+	}
+	return unwrapped
+}
+
+// streamJSON performs an HTTP request for u and calls fn for each successful response, in order, as it is decoded,
+// along with the index of its action within its Request.
+// offset is the index of the first action of u within its Request.
+// Request and API errors are appended to errs. If fn returns an error, streamJSON stops and returns it.
+func (c *Client) streamJSON(ctx context.Context, u string, offset int, fn func(int, Response) error, errs []error) ([]error, error) {
+	resp, err := c.do(ctx, u)
 	if err != nil {
 		errs = append(errs, err)
-		return responses, errs
+		return errs, nil
 	}
 	defer resp.Body.Close()
+	if c.inspector != nil {
+		c.inspector(resp)
+	}
 	if resp.StatusCode != 200 {
 		errs = append(errs, fmt.Errorf("HTTP error: %s", resp.Status))
-		return responses, errs
+		return errs, nil
 	}
 
-	decoder := json.NewDecoder(resp.Body)
+	// guard against e.g. HTML error pages of proxies
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+			errs = append(errs, fmt.Errorf("unexpected response Content-Type %q", contentType))
+			return errs, nil
+		}
+	}
 
-	var responseJSONs []responseJSON
-	if err = decoder.Decode(&responseJSONs); err != nil {
-		errs = append(errs, fmt.Errorf("unable to decode api JSON response"))
-		return responses, errs
+	var body io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to decompress api response: %w", err))
+			return errs, nil
+		}
+		defer gz.Close()
+		body = gz
 	}
 
-	for _, r := range responseJSONs {
+	// keep the beginning of the body, to include it in decode errors
+	snippet := &prefixWriter{max: maxErrorSnippet}
+	decoder := json.NewDecoder(io.TeeReader(body, snippet))
+
+	// decode the array one response at a time
+	if t, err := decoder.Token(); err != nil || t != json.Delim('[') {
+		errs = append(errs, snippet.decodeError(body))
+		return errs, nil
+	}
+	for i := offset; decoder.More(); i++ {
+		var r responseJSON
+		if err = decoder.Decode(&r); err != nil {
+			errs = append(errs, snippet.decodeError(body))
+			return errs, nil
+		}
 		// Check for 'ERROR' attribute for any values, which would indicate an error
 		if len(r.Errors) > 0 {
 			for _, e := range r.Errors {
-				errs = append(errs, fmt.Errorf("[code: %d] %s", e.Code, e.Message))
+				errs = append(errs, APIError{Code: e.Code, Message: e.Message, Action: r.Action})
 			}
 			continue
 		}
-		responses = append(responses, Response{Action: r.Action, Data: r.Data})
+		if err = fn(i, Response{Action: r.Action, Data: r.Data}); err != nil {
+			return errs, err
+		}
+	}
+	return errs, nil
+}
+
+// prefixWriter keeps the first max bytes written to it
+type prefixWriter struct {
+	buf       []byte
+	max       int
+	truncated bool
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	n := w.max - len(w.buf)
+	if n > len(p) {
+		n = len(p)
+	}
+	if n < len(p) {
+		w.truncated = true
+	}
+	w.buf = append(w.buf, p[:n]...)
+	return len(p), nil
+}
+
+// decodeError returns an error including the kept bytes, after keeping what is left to keep from unread
+func (w *prefixWriter) decodeError(unread io.Reader) error {
+	io.Copy(w, io.LimitReader(unread, int64(w.max-len(w.buf)+1)))
+	var ellipsis string
+	if w.truncated {
+		ellipsis = "..."
+	}
+	return fmt.Errorf("unable to decode api JSON response: %q%s", w.buf, ellipsis)
+}
+
+// newHTTPRequest creates a GET request for u, or a form-encoded POST request if u exceeds maxURLLength.
+func (c *Client) newHTTPRequest(ctx context.Context, u string) (*http.Request, error) {
+	method, target, body := "GET", u, ""
+	if c.postOnly || len(u) > maxURLLength {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, err
+		}
+		method, body = "POST", parsed.RawQuery
+		parsed.RawQuery = ""
+		target = parsed.String()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if method == "POST" {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	// setting Accept-Encoding disables the transparent decompression of http.Transport, see streamJSON
+	req.Header.Set("Accept-Encoding", "gzip")
+	return req, nil
+}
+
+// do performs an HTTP request for u. Network errors and 5xx responses are retried as configured by WithRetry.
+// The response of the last attempt is returned, regardless of its status code.
+// Returned errors have the API key redacted from their URL, see redactError.
+func (c *Client) do(ctx context.Context, u string) (*http.Response, error) {
+	attempts := c.maxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	var err error
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err = c.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		var req *http.Request
+		req, err = c.newHTTPRequest(ctx, u)
+		if err != nil {
+			return nil, redactError(err)
+		}
+		c.logf("linode: %s %s (attempt %d)", req.Method, redactURL(u), attempt)
+		if c.pretty && attempt == 1 {
+			c.logf("linode: api_requestArray %s", prettyRequestArray(redactURL(u)))
+		}
+		var resp *http.Response
+		start := time.Now()
+		resp, err = c.httpClient.Do(req)
+		c.recordMetrics(u, resp, time.Since(start), err)
+		if err != nil {
+			c.logf("linode: request failed: %v", redactError(err))
+		} else {
+			c.logf("linode: response status %s", resp.Status)
+		}
+		if err == nil && (resp.StatusCode < 500 || attempt == attempts) {
+			return resp, nil
+		}
+		if err == nil {
+			resp.Body.Close()
+		}
+		if attempt == attempts || ctx.Err() != nil {
+			break
+		}
+		t := time.NewTimer(backoff(c.retryDelay, attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+	return nil, redactError(err)
+}
+
+// recordMetrics calls the client's metrics callback, if any
+func (c *Client) recordMetrics(u string, resp *http.Response, dur time.Duration, err error) {
+	if c.metrics == nil {
+		return
+	}
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	c.metrics(redactURL(u), status, dur, redactError(err))
+}
+
+// logf logs to the client's logger, if any
+func (c *Client) logf(format string, args ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, args...)
+	}
+}
+
+// prettyRequestArray returns the api_requestArray param of u as indented JSON. u must already be redacted.
+func prettyRequestArray(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "<unparsable url>"
+	}
+	requestArray := parsed.Query().Get("api_requestArray")
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(requestArray), "", "  "); err != nil {
+		return requestArray
+	}
+	return buf.String()
+}
+
+// credentialParams are action params whose values must not be logged, see redactActions
+var credentialParams = map[string]bool{
+	"password": true,
+}
+
+// redactActions returns a copy of actions with the values of credential params replaced by "***"
+func redactActions(actions []action) []action {
+	redacted := make([]action, len(actions))
+	for i, a := range actions {
+		redacted[i] = make(action, len(a))
+		for k, v := range a {
+			if credentialParams[strings.ToLower(k)] {
+				v = "***"
+			}
+			redacted[i][k] = v
+		}
+	}
+	return redacted
+}
+
+// redactURL returns u with the value of the api_key param, and of credential params in api_requestArray, replaced by "***"
+func redactURL(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "<unparsable url>"
+	}
+	query := parsed.Query()
+	if requestArray := query.Get("api_requestArray"); requestArray != "" {
+		var actions []action
+		if err := json.Unmarshal([]byte(requestArray), &actions); err != nil {
+			// never risk logging credentials which could not be found
+			query.Set("api_requestArray", "***")
+		} else if redacted, err := json.Marshal(redactActions(actions)); err == nil {
+			query.Set("api_requestArray", string(redacted))
+		}
+	}
+	if query.Get("api_key") != "" {
+		query.Set("api_key", "***")
+	}
+	// keep the masks readable, rather than percent-encoded
+	parsed.RawQuery = strings.ReplaceAll(query.Encode(), "%2A%2A%2A", "***")
+	return parsed.String()
+}
+
+// redactError redacts the url of errors returned by http.Client
+func redactError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		redacted := *urlErr
+		redacted.URL = redactURL(urlErr.URL)
+		return &redacted
+	}
+	return err
+}
+
+// backoff returns the delay before retrying after the given attempt: base doubled for each attempt, with jitter.
+// The doubling stops at maxRetryDelay, or at base if it is larger, so that d cannot overflow.
+func backoff(base time.Duration, attempt int) time.Duration {
+	limit := maxRetryDelay
+	if base > limit {
+		limit = base
+	}
+	d := base
+	for i := 1; i < attempt && d < limit; i++ {
+		if d > limit/2 {
+			d = limit
+		} else {
+			d *= 2
+		}
+	}
+	if d <= 1 {
+		return d
 	}
-	return responses, errs
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)))
 }
 
 // responseJSON represents the JSON returned by the API
@@ -1,11 +1,21 @@
 package linode
 
 import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 const testAPIKey = "abc123"
@@ -17,11 +27,114 @@ func newTestClient() *Client {
 func TestNewRequest(t *testing.T) {
 	c := newTestClient()
 	r := c.NewRequest()
-	if r.client != *c {
+	if !reflect.DeepEqual(r.client, *c) {
 		t.Error("incorrect request client")
 	}
 }
 
+func TestNewClientOptions(t *testing.T) {
+	hc := &http.Client{}
+	c := NewClient(testAPIKey, WithHTTPClient(hc), WithTimeout(time.Second), WithBaseURL("http://localhost:8080/"))
+	if c.httpClient == hc {
+		t.Error("expected WithTimeout to copy the http client")
+	}
+	if hc.Timeout != 0 {
+		t.Error("expected", 0, "given", hc.Timeout)
+	}
+	if c.httpClient.Timeout != time.Second {
+		t.Error("expected", time.Second, "given", c.httpClient.Timeout)
+	}
+	if c.baseURL.String() != "http://localhost:8080/" {
+		t.Error("expected", "http://localhost:8080/", "given", c.baseURL)
+	}
+
+	c = NewClient(testAPIKey, WithTimeout(time.Second), WithHTTPClient(hc))
+	if c.httpClient == hc {
+		t.Error("expected WithTimeout to copy the http client")
+	}
+	if hc.Timeout != 0 {
+		t.Error("expected", 0, "given", hc.Timeout)
+	}
+	if c.httpClient.Timeout != time.Second {
+		t.Error("expected", time.Second, "given", c.httpClient.Timeout)
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	t.Setenv(apiKeyEnv, "")
+	if _, err := NewClientFromEnv(); err == nil {
+		t.Error("expected error for empty", apiKeyEnv)
+	}
+
+	t.Setenv(apiKeyEnv, testAPIKey)
+	c, err := NewClientFromEnv(WithUserAgent("test"))
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if c.apiKey != testAPIKey {
+		t.Error("expected", testAPIKey, "given", c.apiKey)
+	}
+	if c.userAgent != "test" {
+		t.Error("expected", "test", "given", c.userAgent)
+	}
+}
+
+func TestNewClientFromFile(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewClientFromFile(filepath.Join(dir, "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Error("expected", os.ErrNotExist, "given", err)
+	}
+
+	empty := filepath.Join(dir, "empty")
+	if err := os.WriteFile(empty, []byte("\n  \n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewClientFromFile(empty); err == nil {
+		t.Error("expected error for empty file")
+	}
+
+	path := filepath.Join(dir, "key")
+	if err := os.WriteFile(path, []byte("\n  "+testAPIKey+"  \nother\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClientFromFile(path, WithUserAgent("test"))
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if c.apiKey != testAPIKey {
+		t.Error("expected", testAPIKey, "given", c.apiKey)
+	}
+	if c.userAgent != "test" {
+		t.Error("expected", "test", "given", c.userAgent)
+	}
+}
+
+func TestWithBaseURLInvalid(t *testing.T) {
+	for _, rawurl := range []string{"://bad", "api.linode.com", "ftp://api.linode.com/"} {
+		c := NewClient(testAPIKey, WithBaseURL(rawurl))
+		r := c.NewRequest().AddAction("test", nil)
+		if _, err := r.URLs(); err == nil {
+			t.Error("expected error for base URL", rawurl)
+		}
+	}
+}
+
+func TestGetJSONWithBaseURL(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	responses, err := c.NewRequest().AddAction("test.echo", nil).GetJSON()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if len(responses) != 1 || responses[0].Action != "test.echo" {
+		t.Error("unexpected responses", responses)
+	}
+}
+
 func TestRequestURLsEmpty(t *testing.T) {
 	c := newTestClient()
 	r := c.NewRequest()
@@ -35,7 +148,7 @@ func TestRequestURLsEmpty(t *testing.T) {
 }
 
 func testURL(requestArray string) string {
-	return fmt.Sprintf("%s?api_action=batch&api_key=%s&api_requestArray=%s", apiEndpoint, testAPIKey, url.QueryEscape(requestArray))
+	return fmt.Sprintf("%s?api_action=batch&api_key=%s&api_requestArray=%s&api_responseFormat=json", apiEndpoint, testAPIKey, url.QueryEscape(requestArray))
 }
 
 // NOTE: maps, when converted to JSON, are sorted by their keys first. Take note when constructing expected urls
@@ -102,6 +215,121 @@ func TestRequestURLs(t *testing.T) {
 	}
 }
 
+// run with -race to detect concurrent modification of the base URL
+func TestRequestURLsConcurrent(t *testing.T) {
+	c := newTestClient()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			method := fmt.Sprintf("testAction%d", i)
+			urls, err := c.NewRequest().AddAction(method, nil).URLs()
+			if err != nil {
+				t.Error("unexpected error", err)
+				return
+			}
+			expected := testURL(`[{"api_action":"` + method + `"}]`)
+			if len(urls) != 1 || urls[0] != expected {
+				t.Error("expected", expected, "given", urls)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if apiEndpointURL.RawQuery != "" {
+		t.Error("expected base URL to be unmodified, given", apiEndpointURL)
+	}
+}
+
+func TestRequestAddActionCopiesParams(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest()
+	params := map[string]string{"LinodeID": "1"}
+	r.AddAction("testAction1", params)
+	r.AddAction("testAction2", params)
+	if len(params) != 1 || params["LinodeID"] != "1" {
+		t.Error("expected params to be untouched, given", params)
+	}
+	urls, err := r.URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := testURL(`[{"LinodeID":"1","api_action":"testAction1"},{"LinodeID":"1","api_action":"testAction2"}]`)
+	if len(urls) != 1 || urls[0] != expected {
+		t.Error("expected", expected, "given", urls)
+	}
+}
+
+func TestRequestAddActionValues(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest()
+	r.AddActionValues("testAction", map[string]interface{}{"a": 1, "b": true, "c": false, "d": "str", "e": 1.5})
+	urls, err := r.URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := testURL(`[{"a":"1","api_action":"testAction","b":"1","c":"0","d":"str","e":"1.5"}]`)
+	if len(urls) != 1 || urls[0] != expected {
+		t.Error("expected", expected, "given", urls)
+	}
+}
+
+func TestRequestReset(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest().AddAction("testAction1", nil)
+	urls, err := r.Reset().AddAction("testAction2", nil).URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := testURL(`[{"api_action":"testAction2"}]`)
+	if len(urls) != 1 || urls[0] != expected {
+		t.Error("expected", expected, "given", urls)
+	}
+}
+
+func TestRequestWithAPIKey(t *testing.T) {
+	c := newTestClient()
+	urls, err := c.NewRequest().WithAPIKey("other").AddAction("test.echo", nil).URLs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := strings.Replace(testURL(`[{"api_action":"test.echo"}]`), "api_key="+testAPIKey, "api_key=other", 1)
+	if len(urls) != 1 || urls[0] != expected {
+		t.Error("expected", expected, "given", urls)
+	}
+	if c.apiKey != testAPIKey {
+		t.Error("expected client API key to be unchanged, given", c.apiKey)
+	}
+}
+
+func TestRequestDedupe(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest()
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "1"})
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "2"})
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "1"})
+	r.AddTaggedAction("one", "linode.ip.list", map[string]string{"LinodeID": "1"})
+	r.AddAction("linode.list", nil)
+	r.AddAction("linode.list", nil)
+	if n := r.Dedupe().ActionCount(); n != 4 {
+		t.Error("expected", 4, "given", n)
+	}
+	urls, err := r.URLs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := testURL(`[{"LinodeID":"1","api_action":"linode.ip.list"},{"LinodeID":"2","api_action":"linode.ip.list"},{"LinodeID":"1","api_action":"linode.ip.list"},{"api_action":"linode.list"}]`)
+	if len(urls) != 1 || urls[0] != expected {
+		t.Error("expected", expected, "given", urls)
+	}
+	if len(r.tags) != 4 || r.tags[2] != "one" {
+		t.Error("unexpected tags", r.tags)
+	}
+}
+
 func TestRequestURLsBatchLimit(t *testing.T) {
 	iter := make([]interface{}, maxBatchRequests)
 
@@ -128,20 +356,231 @@ func TestRequestURLsBatchLimit(t *testing.T) {
 	}
 }
 
+func TestRequestCounts(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest()
+	if r.ActionCount() != 0 || r.BatchCount() != 0 {
+		t.Error("expected", 0, "given", r.ActionCount(), r.BatchCount())
+	}
+	for i := 0; i < maxBatchRequests; i++ {
+		r.AddAction("test", nil)
+	}
+	if r.ActionCount() != maxBatchRequests {
+		t.Error("expected", maxBatchRequests, "given", r.ActionCount())
+	}
+	if r.BatchCount() != 1 {
+		t.Error("expected", 1, "given", r.BatchCount())
+	}
+	r.AddAction("straw", nil)
+	if r.ActionCount() != maxBatchRequests+1 {
+		t.Error("expected", maxBatchRequests+1, "given", r.ActionCount())
+	}
+	if r.BatchCount() != 2 {
+		t.Error("expected", 2, "given", r.BatchCount())
+	}
+}
+
+func TestRequestURLsBatchSizes(t *testing.T) {
+	cases := []struct {
+		actions int
+		batches int
+	}{
+		{24, 1},
+		{25, 2},
+		{48, 2},
+		{49, 3},
+	}
+	for _, testCase := range cases {
+		c := newTestClient()
+		r := c.NewRequest()
+		for i := 0; i < testCase.actions; i++ {
+			r.AddAction("test", nil)
+		}
+		urls, err := r.URLs()
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if len(urls) != testCase.batches {
+			t.Error("expected", testCase.batches, "given", len(urls))
+		}
+		var total int
+		for _, rawurl := range urls {
+			u, err := url.Parse(rawurl)
+			if err != nil {
+				t.Error("unexpected error", err)
+				continue
+			}
+			var actions []map[string]string
+			if err = json.Unmarshal([]byte(u.Query().Get("api_requestArray")), &actions); err != nil {
+				t.Error("unexpected error", err)
+				continue
+			}
+			if len(actions) == 0 || len(actions) > maxBatchRequests {
+				t.Error("expected between 1 and", maxBatchRequests, "actions, given", len(actions))
+			}
+			total += len(actions)
+		}
+		if total != testCase.actions {
+			t.Error("expected", testCase.actions, "given", total)
+		}
+	}
+}
+
+func TestRequestURLsCustomBatchLimit(t *testing.T) {
+	c := NewClient(testAPIKey, WithBatchLimit(2))
+	r := c.NewRequest()
+	for i := 0; i < 2; i++ {
+		r.AddAction("test", nil)
+	}
+	urls, err := r.URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(urls) != 1 {
+		t.Error("expected", 1, "given", len(urls))
+	}
+	r.AddAction("straw", nil)
+	urls, err = r.URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(urls) != 2 {
+		t.Error("expected", 2, "given", len(urls))
+	}
+
+	c = NewClient(testAPIKey, WithBatchLimit(0))
+	if _, err = c.NewRequest().AddAction("test", nil).URLs(); err == nil {
+		t.Error("expected error for invalid batch limit")
+	}
+}
+
+func TestRequestURLsMaxBatches(t *testing.T) {
+	c := NewClient(testAPIKey, WithBatchLimit(2), WithMaxBatches(2))
+	r := c.NewRequest()
+	for i := 0; i < 4; i++ {
+		r.AddAction("test", nil)
+	}
+	urls, err := r.URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(urls) != 2 {
+		t.Error("expected", 2, "given", len(urls))
+	}
+	r.AddAction("straw", nil)
+	if _, err = r.URLs(); err == nil {
+		t.Error("expected error for too many batches")
+	}
+
+	c = NewClient(testAPIKey, WithMaxBatches(0))
+	if _, err = c.NewRequest().AddAction("test", nil).URLs(); err == nil {
+		t.Error("expected error for invalid max batches")
+	}
+}
+
+func TestGetJSONActionBudget(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"},{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithBatchLimit(1), WithActionBudget(2))
+	r := c.NewRequest().AddAction("test.echo", nil).AddAction("test.echo", nil)
+	if n := r.EstimatedActions(); n != 2 {
+		t.Error("expected", 2, "given", n)
+	}
+	if _, err := r.GetJSON(); err != nil {
+		t.Error("unexpected error", err)
+	}
+	r.AddAction("test.echo", nil)
+	if _, err := r.GetJSON(); err == nil {
+		t.Error("expected error for exceeded action budget")
+	}
+
+	c = NewClient(testAPIKey, WithActionBudget(0))
+	if _, err := c.NewRequest().AddAction("test", nil).URLs(); err == nil {
+		t.Error("expected error for invalid action budget")
+	}
+}
+
+func TestRequestRedacted(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest().AddAction("test.echo", map[string]string{"a": "b"})
+	urls, err := r.RedactedURLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	expected := strings.Replace(testURL(`[{"a":"b","api_action":"test.echo"}]`), testAPIKey, "***", 1)
+	if len(urls) != 1 || urls[0] != expected {
+		t.Error("expected", expected, "given", urls)
+	}
+	for _, s := range []string{fmt.Sprint(r), fmt.Sprintf("%+v", *r), fmt.Sprintf("%v", c)} {
+		if strings.Contains(s, testAPIKey) {
+			t.Error("expected API key to be redacted, given", s)
+		}
+	}
+}
+
+func TestGetJSONErrorRedacted(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close() // refuse connections
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	_, err := c.NewRequest().AddAction("test.echo", nil).GetJSON()
+	if err == nil {
+		t.Fatal("expected error for refused connection")
+	}
+	if strings.Contains(err.Error(), testAPIKey) {
+		t.Error("expected API key to be redacted, given", err)
+	}
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Error("expected *url.Error, given", err)
+	}
+}
+
 func newTestServer(status int, response string) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(status)
 		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(status)
 		fmt.Fprintln(w, response)
 	}))
 }
 
-func TestGetJSONWithJSONError(t *testing.T) {
-	server := newTestServer(200, `[{"ERRORARRAY":[{"ERRORCODE":11,"ERRORMESSAGE":"RequestArray isn't valid JSON or WDDX"}],"DATA":{},"ACTION":"batch"}]`)
+// writeJSON writes response with a JSON Content-Type, like the API does
+func writeJSON(w http.ResponseWriter, response string) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	fmt.Fprintln(w, response)
+}
+
+// newTestServerClient returns a test server responding with response, and a client using it as base URL
+func newTestServerClient(response string) (*Client, *httptest.Server) {
+	server := newTestServer(200, response)
+	return NewClient(testAPIKey, WithBaseURL(server.URL)), server
+}
+
+// streamTestJSON collects the responses and errors of streamJSON for u
+func streamTestJSON(c *Client, u string) ([]Response, []error) {
 	var responses []Response
-	var errors []error
+	errs, _ := c.streamJSON(context.Background(), u, 0, func(_ int, r Response) error {
+		responses = append(responses, r)
+		return nil
+	}, nil)
+	return responses, errs
+}
+
+// newRecordingTestServerClient is like newTestServerClient, but also records the api_requestArray param of the last request
+func newRecordingTestServerClient(response string) (*Client, *httptest.Server, *string) {
+	var requestArray string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestArray = r.FormValue("api_requestArray")
+		writeJSON(w, response)
+	}))
+	return NewClient(testAPIKey, WithBaseURL(server.URL)), server, &requestArray
+}
 
-	responses, errors = getJSON(server.URL, responses, errors)
+func TestGetJSONWithJSONError(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[{"ERRORCODE":11,"ERRORMESSAGE":"RequestArray isn't valid JSON or WDDX"}],"DATA":{},"ACTION":"batch"}]`)
+	responses, errors := streamTestJSON(newTestClient(), server.URL)
 	if len(responses) != 0 {
 		t.Error("expected", 0, "given", len(responses))
 	}
@@ -156,12 +595,168 @@ func TestGetJSONWithJSONError(t *testing.T) {
 	}
 }
 
+func TestGetJSONAPIErrors(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[{"ERRORCODE":4,"ERRORMESSAGE":"Authentication failed"}],"DATA":{},"ACTION":"test.echo"}]`)
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	_, err := c.NewRequest().AddAction("test.echo", nil).GetJSON()
+	apiErrs, ok := err.(APIErrors)
+	if !ok {
+		t.Error("expected APIErrors, given", err)
+		return
+	}
+	if len(apiErrs) != 1 {
+		t.Error("expected", 1, "given", len(apiErrs))
+		return
+	}
+	expected := APIError{Code: 4, Message: "Authentication failed", Action: "test.echo"}
+	if apiErrs[0] != expected {
+		t.Error("expected", expected, "given", apiErrs[0])
+	}
+	if err.Error() != "[code: 4] Authentication failed" {
+		t.Error("expected", "[code: 4] Authentication failed", "given", err.Error())
+	}
+}
+
+func TestJoinErrorsAs(t *testing.T) {
+	apiErr := APIError{Code: 4, Message: "Authentication failed", Action: "test.echo"}
+	cases := []struct {
+		errs    []error
+		message string
+	}{
+		{[]error{apiErr}, "[code: 4] Authentication failed"},
+		{[]error{errors.New("HTTP error: 502 Bad Gateway"), apiErr}, "HTTP error: 502 Bad Gateway; [code: 4] Authentication failed"},
+	}
+	for _, testCase := range cases {
+		err := joinErrors(testCase.errs)
+		if err.Error() != testCase.message {
+			t.Error("expected", testCase.message, "given", err)
+		}
+		var given APIError
+		if !errors.As(err, &given) {
+			t.Error("expected errors.As to extract APIError from", err)
+			continue
+		}
+		if given != apiErr {
+			t.Error("expected", apiErr, "given", given)
+		}
+	}
+
+	err := joinErrors([]error{context.Canceled, errors.New("other")})
+	if !errors.Is(err, context.Canceled) {
+		t.Error("expected errors.Is to match", context.Canceled, "given", err)
+	}
+}
+
+func TestGetJSONPartialResponses(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":[],"ACTION":"linode.ip.list"},{"ERRORARRAY":[{"ERRORCODE":5,"ERRORMESSAGE":"Object not found"}],"DATA":{},"ACTION":"linode.ip.list"}]`)
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	r := c.NewRequest()
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "1"})
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "2"})
+	responses, err := r.GetJSON()
+	if err == nil {
+		t.Error("expected error")
+	}
+	if len(responses) != 1 {
+		t.Error("expected", 1, "given", len(responses))
+	}
+}
+
+func TestGetJSONReusedRequest(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+		if first {
+			writeJSON(w, `[{"ERRORARRAY":[{"ERRORCODE":5,"ERRORMESSAGE":"Object not found"}],"DATA":{},"ACTION":"linode.ip.list"}]`)
+			return
+		}
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":[],"ACTION":"linode.ip.list"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	r := c.NewRequest().AddAction("linode.ip.list", map[string]string{"LinodeID": "1"})
+	if _, err := r.GetJSON(); err == nil {
+		t.Error("expected error")
+	}
+	responses, err := r.GetJSON()
+	if err != nil {
+		t.Error("expected errors of the first call to be cleared, given", err)
+	}
+	if len(responses) != 1 {
+		t.Error("expected", 1, "given", len(responses))
+	}
+}
+
+func TestGetJSONFailFast(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		writeJSON(w, `[{"ERRORARRAY":[{"ERRORCODE":5,"ERRORMESSAGE":"Object not found"}],"DATA":{},"ACTION":"linode.ip.list"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithBatchLimit(1), WithFailFast())
+	r := c.NewRequest()
+	for i := 1; i <= 3; i++ {
+		r.AddAction("linode.ip.list", map[string]string{"LinodeID": fmt.Sprint(i)})
+	}
+	_, err := r.GetJSON()
+	apiErrs, ok := err.(APIErrors)
+	if !ok || len(apiErrs) != 1 {
+		t.Error("expected a single API error, given", err)
+	}
+	if requests != 1 {
+		t.Error("expected", 1, "given", requests)
+	}
+}
+
+func TestGetJSONGzip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Error("expected", "gzip", "given", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		fmt.Fprint(gz, `[{"ERRORARRAY":[],"DATA":{"LinodeID":8098},"ACTION":"linode.ip.list"},{"ERRORARRAY":[],"DATA":{"LinodeID":8099},"ACTION":"linode.ip.list"}]`)
+		gz.Close()
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	r := c.NewRequest()
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "8098"})
+	r.AddAction("linode.ip.list", map[string]string{"LinodeID": "8099"})
+	responses, err := r.GetJSON()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := []string{`{"LinodeID":8098}`, `{"LinodeID":8099}`}
+	if len(responses) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(responses))
+	}
+	for i, data := range expected {
+		if string(responses[i].Data) != data {
+			t.Error("expected", data, "given", string(responses[i].Data))
+		}
+	}
+}
+
 func TestGetJSONWithJSONData(t *testing.T) {
 	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":[{"ALERT_CPU_ENABLED":1,"ALERT_BWIN_ENABLED":1}],"ACTION":"linode.test"}]`)
-	var responses []Response
-	var errors []error
-
-	responses, errors = getJSON(server.URL, responses, errors)
+	responses, errors := streamTestJSON(newTestClient(), server.URL)
 	if len(errors) != 0 {
 		t.Error("expected", 0, "given", len(errors))
 		return
@@ -193,10 +788,7 @@ func TestGetJSONWithJSONData(t *testing.T) {
 
 func TestGetJSONWithJSONMultipleData(t *testing.T) {
 	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"},{"ERRORARRAY":[],"DATA":[{"LOCATION":"Dallas, TX, USA","DATACENTERID":2,"ABBR":"dallas"},{"LOCATION":"Fremont, CA, USA","DATACENTERID":3,"ABBR":"fremont"}],"ACTION":"avail.datacenters"}]`)
-	var responses []Response
-	var errors []error
-
-	responses, errors = getJSON(server.URL, responses, errors)
+	responses, errors := streamTestJSON(newTestClient(), server.URL)
 	if len(errors) != 0 {
 		t.Error("expected", 0, "given", len(errors))
 		return
@@ -231,24 +823,420 @@ func TestGetJSONWithJSONMultipleData(t *testing.T) {
 
 func TestGetJSONWithNon200(t *testing.T) {
 	server := newTestServer(500, `[{"ERRORARRAY":[],"DATA":{},"ACTION":""}]`)
-	var responses []Response
-	var errors []error
+	_, errors := streamTestJSON(newTestClient(), server.URL)
+	if len(errors) != 1 {
+		t.Error("expected", 1, "given", len(errors))
+		return
+	}
+}
 
-	responses, errors = getJSON(server.URL, responses, errors)
+func TestGetJSONWithHTMLContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintln(w, "<html><body>Bad Gateway</body></html>")
+	}))
+	defer server.Close()
+	_, errors := streamTestJSON(newTestClient(), server.URL)
 	if len(errors) != 1 {
 		t.Error("expected", 1, "given", len(errors))
 		return
 	}
+	expectedError := `unexpected response Content-Type "text/html; charset=utf-8"`
+	if errors[0].Error() != expectedError {
+		t.Error("expected", expectedError, "given", errors[0])
+	}
 }
 
 func TestGetJSONWithInvalidJSON(t *testing.T) {
 	server := newTestServer(200, `i am no json`)
-	var responses []Response
-	var errors []error
+	_, errors := streamTestJSON(newTestClient(), server.URL)
+	if len(errors) != 1 {
+		t.Error("expected", 1, "given", len(errors))
+		return
+	}
+	expectedError := `unable to decode api JSON response: "i am no json\n"`
+	if errors[0].Error() != expectedError {
+		t.Error("expected", expectedError, "given", errors[0])
+	}
+}
 
-	responses, errors = getJSON(server.URL, responses, errors)
+func TestGetJSONWithInvalidJSONTruncated(t *testing.T) {
+	server := newTestServer(200, strings.Repeat("x", 2*maxErrorSnippet))
+	_, errors := streamTestJSON(newTestClient(), server.URL)
 	if len(errors) != 1 {
 		t.Error("expected", 1, "given", len(errors))
 		return
 	}
+	expectedError := fmt.Sprintf("unable to decode api JSON response: %q...", strings.Repeat("x", maxErrorSnippet))
+	if errors[0].Error() != expectedError {
+		t.Error("expected", expectedError, "given", errors[0])
+	}
+}
+
+func TestGetJSONContextCanceled(t *testing.T) {
+	c := newTestClient()
+	r := c.NewRequest()
+	r.AddAction("test", nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	responses, err := r.GetJSONContext(ctx)
+	if err == nil || err.Error() != context.Canceled.Error() {
+		t.Error("expected", context.Canceled, "given", err)
+	}
+	if len(responses) != 0 {
+		t.Error("expected", 0, "given", len(responses))
+	}
+}
+
+func TestGetJSONRetry(t *testing.T) {
+	cases := []struct {
+		status   int
+		expected int
+	}{
+		{500, 3},
+		{503, 3},
+		{404, 1},
+	}
+	for _, testCase := range cases {
+		var hits int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits++
+			w.WriteHeader(testCase.status)
+		}))
+
+		c := NewClient(testAPIKey, WithBaseURL(server.URL), WithRetry(3, time.Millisecond))
+		_, err := c.NewRequest().AddAction("test.echo", nil).GetJSON()
+		if err == nil {
+			t.Error("expected error for status", testCase.status)
+		}
+		if hits != testCase.expected {
+			t.Error("expected", testCase.expected, "given", hits)
+		}
+		server.Close()
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		base     time.Duration
+		attempt  int
+		min, max time.Duration
+	}{
+		{10 * time.Millisecond, 1, 5 * time.Millisecond, 10 * time.Millisecond},
+		{10 * time.Millisecond, 3, 20 * time.Millisecond, 40 * time.Millisecond},
+		{time.Second, 40, maxRetryDelay / 2, maxRetryDelay},
+		{time.Second, 1000, maxRetryDelay / 2, maxRetryDelay},
+		{2 * time.Hour, 5, time.Hour, 2 * time.Hour},
+	}
+	for _, testCase := range cases {
+		d := backoff(testCase.base, testCase.attempt)
+		if d < testCase.min || d > testCase.max {
+			t.Error("expected", testCase.min, "to", testCase.max, "given", d, "for attempt", testCase.attempt)
+		}
+	}
+}
+
+func TestGetJSONRateLimit(t *testing.T) {
+	server := newTestServer(200, `[]`)
+	defer server.Close()
+
+	// 1 request every 20ms, so 3 requests take at least 40ms
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithRateLimit(50, 1))
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.NewRequest().AddAction("test.echo", nil).GetJSON(); err != nil {
+			t.Error("unexpected error", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Error("expected requests to be paced, given", elapsed)
+	}
+}
+
+func TestGetJSONRateLimitContext(t *testing.T) {
+	c := NewClient(testAPIKey, WithRateLimit(0.001, 1))
+	c.limiter.Allow() // use up the burst
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.NewRequest().AddAction("test.echo", nil).GetJSONContext(ctx); err == nil {
+		t.Error("expected error when limiter wait exceeds the context deadline")
+	}
+}
+
+func TestGetJSONConcurrency(t *testing.T) {
+	const latency = 50 * time.Millisecond
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(latency)
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithConcurrency(4))
+	r := c.NewRequest()
+	for i := 0; i < maxBatchRequests*4; i++ {
+		r.AddAction("test.echo", nil)
+	}
+	urls, err := r.URLs()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+
+	start := time.Now()
+	responses, err := r.GetJSON()
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(responses) != len(urls) {
+		t.Error("expected", len(urls), "given", len(responses))
+	}
+	if elapsed >= time.Duration(len(urls))*latency {
+		t.Error("expected concurrent requests, given", elapsed)
+	}
+}
+
+func TestGetJSONLargeBatchUsesPOST(t *testing.T) {
+	var method, requestArray string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		requestArray = r.PostFormValue("api_requestArray")
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	r := c.NewRequest()
+	for i := 0; i < maxBatchRequests; i++ {
+		r.AddAction("test.echo", map[string]string{"padding": strings.Repeat("x", 200)})
+	}
+	responses, err := r.GetJSON()
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if method != "POST" {
+		t.Error("expected", "POST", "given", method)
+	}
+	if requestArray == "" {
+		t.Error("expected api_requestArray in POST body")
+	}
+	if len(responses) != 1 {
+		t.Error("expected", 1, "given", len(responses))
+	}
+}
+
+func TestGetJSONUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+		writeJSON(w, `[]`)
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		opts     []Option
+		expected string
+	}{
+		{nil, defaultUserAgent},
+		{[]Option{WithUserAgent("myapp/2.0")}, "myapp/2.0"},
+	}
+	for _, testCase := range cases {
+		opts := append([]Option{WithBaseURL(server.URL)}, testCase.opts...)
+		c := NewClient(testAPIKey, opts...)
+		if _, err := c.NewRequest().AddAction("test.echo", nil).GetJSON(); err != nil {
+			t.Error("unexpected error", err)
+		}
+		if userAgent != testCase.expected {
+			t.Error("expected", testCase.expected, "given", userAgent)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"},{"ERRORARRAY":[],"DATA":[],"ACTION":"avail.datacenters"}]`)
+	defer server.Close()
+
+	r := c.NewRequest().AddAction("test.echo", nil).AddAction("avail.datacenters", nil)
+	var actions []string
+	err := r.Stream(context.Background(), func(resp Response) error {
+		actions = append(actions, resp.Action)
+		return nil
+	})
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(actions) != 2 || actions[0] != "test.echo" || actions[1] != "avail.datacenters" {
+		t.Error("unexpected actions", actions)
+	}
+
+	// an error returned by fn stops the stream
+	stop := errors.New("stop")
+	actions = nil
+	err = r.Stream(context.Background(), func(resp Response) error {
+		actions = append(actions, resp.Action)
+		return stop
+	})
+	if err != stop {
+		t.Error("expected", stop, "given", err)
+	}
+	if len(actions) != 1 {
+		t.Error("expected", 1, "given", len(actions))
+	}
+}
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Printf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestGetJSONLogger(t *testing.T) {
+	server := newTestServer(200, `[]`)
+	defer server.Close()
+
+	logger := &testLogger{}
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithLogger(logger))
+	if _, err := c.NewRequest().AddAction("test.echo", nil).GetJSON(); err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(logger.lines) != 2 {
+		t.Error("expected", 2, "given", len(logger.lines))
+	}
+	for _, line := range logger.lines {
+		if strings.Contains(line, testAPIKey) {
+			t.Error("expected API key to be redacted, given", line)
+		}
+	}
+}
+
+func TestGetJSONPrettyRequestArray(t *testing.T) {
+	server := newTestServer(200, `[]`)
+	defer server.Close()
+
+	logger := &testLogger{}
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithLogger(logger), WithPrettyRequestArray())
+	r := c.NewRequest().AddAction("test.echo", map[string]string{"foo": "bar"})
+	if _, err := r.GetJSON(); err != nil {
+		t.Error("unexpected error", err)
+	}
+	expected := "linode: api_requestArray [\n  {\n    \"api_action\": \"test.echo\",\n    \"foo\": \"bar\"\n  }\n]"
+	if len(logger.lines) != 3 || logger.lines[1] != expected {
+		t.Error("expected", expected, "given", logger.lines)
+	}
+
+	urls, err := r.URLs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if compact := url.QueryEscape(`[{"api_action":"test.echo","foo":"bar"}]`); len(urls) != 1 || !strings.Contains(urls[0], compact) {
+		t.Error("expected compact request array, given", urls)
+	}
+}
+
+func TestGetJSONMetrics(t *testing.T) {
+	server := newTestServer(503, `[]`)
+	defer server.Close()
+
+	var statuses []int
+	var urls []string
+	metrics := func(url string, status int, dur time.Duration, err error) {
+		statuses = append(statuses, status)
+		urls = append(urls, url)
+		if err != nil {
+			t.Error("unexpected error", err)
+		}
+		if dur <= 0 {
+			t.Error("expected positive duration, given", dur)
+		}
+	}
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithRetry(2, time.Millisecond), WithMetrics(metrics))
+	if _, err := c.NewRequest().AddAction("test.echo", nil).GetJSON(); err == nil {
+		t.Error("expected error")
+	}
+	if len(statuses) != 2 || statuses[0] != 503 || statuses[1] != 503 {
+		t.Error("expected", []int{503, 503}, "given", statuses)
+	}
+	for _, u := range urls {
+		if strings.Contains(u, testAPIKey) {
+			t.Error("expected API key to be redacted, given", u)
+		}
+	}
+}
+
+func TestGetJSONResponseInspector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	}))
+	defer server.Close()
+
+	var remaining []string
+	inspector := func(resp *http.Response) {
+		remaining = append(remaining, resp.Header.Get("X-RateLimit-Remaining"))
+	}
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithResponseInspector(inspector))
+	responses, err := c.NewRequest().AddAction("test.echo", nil).GetJSON()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if len(responses) != 1 {
+		t.Error("expected", 1, "given", len(responses))
+	}
+	if len(remaining) != 1 || remaining[0] != "42" {
+		t.Error("expected", []string{"42"}, "given", remaining)
+	}
+}
+
+func TestGetJSONTagged(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"LINODEID":1}],"ACTION":"linode.ip.list"},{"ERRORARRAY":[{"ERRORCODE":5,"ERRORMESSAGE":"Object not found"}],"DATA":{},"ACTION":"linode.ip.list"},{"ERRORARRAY":[],"DATA":[{"LINODEID":3}],"ACTION":"linode.ip.list"}]`)
+	defer server.Close()
+
+	r := c.NewRequest()
+	r.AddTaggedAction("one", "linode.ip.list", map[string]string{"LinodeID": "1"})
+	r.AddTaggedAction("two", "linode.ip.list", map[string]string{"LinodeID": "2"})
+	r.AddTaggedAction("three", "linode.ip.list", map[string]string{"LinodeID": "3"})
+	tagged, err := r.GetJSONTagged()
+	if err == nil {
+		t.Error("expected error")
+	}
+	expected := map[string]string{
+		"one":   `[{"LINODEID":1}]`,
+		"three": `[{"LINODEID":3}]`,
+	}
+	if len(tagged) != len(expected) {
+		t.Error("expected", len(expected), "given", len(tagged))
+	}
+	for tag, data := range expected {
+		if string(tagged[tag].Data) != data {
+			t.Error("expected", data, "given", string(tagged[tag].Data))
+		}
+	}
+}
+
+// run with -race to detect unsafe sharing of the client
+func TestClientConcurrentRequests(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithRateLimit(1000, 10), WithConcurrency(2))
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses, err := c.NewRequest().AddAction("test.echo", nil).GetJSON()
+			if err != nil {
+				t.Error("unexpected error", err)
+				return
+			}
+			if len(responses) != 1 {
+				t.Error("expected", 1, "given", len(responses))
+			}
+		}()
+	}
+	wg.Wait()
 }
@@ -0,0 +1,249 @@
+package linode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+const (
+	availDatacentersAction   = "avail.datacenters"
+	availDistributionsAction = "avail.distributions"
+	availKernelsAction       = "avail.kernels"
+	availLinodePlansAction   = "avail.linodeplans"
+	availNodeBalancersAction = "avail.nodebalancers"
+
+	maxKernelPages = 100 // guards against endless paging of avail.kernels
+)
+
+// DatacenterList returns slice of Datacenters, sorted by ID
+func (c *Client) DatacenterList() ([]Datacenter, error) {
+	return c.DatacenterListContext(context.Background())
+}
+
+// DatacenterListContext is like DatacenterList, with ctx to cancel the API requests
+func (c *Client) DatacenterListContext(ctx context.Context) ([]Datacenter, error) {
+	var datacenters sortedDatacenters
+	if err := c.doActionContext(ctx, availDatacentersAction, nil, &datacenters); err != nil {
+		return nil, err
+	}
+	sort.Sort(datacenters)
+
+	return []Datacenter(datacenters), nil
+}
+
+// DistributionList returns slice of Distributions, sorted by Label
+func (c *Client) DistributionList() ([]Distribution, error) {
+	return c.DistributionListContext(context.Background())
+}
+
+// DistributionListContext is like DistributionList, with ctx to cancel the API requests
+func (c *Client) DistributionListContext(ctx context.Context) ([]Distribution, error) {
+	var distributions sortedDistributions
+	if err := c.doActionContext(ctx, availDistributionsAction, nil, &distributions); err != nil {
+		return nil, err
+	}
+	sort.Sort(distributions)
+
+	return []Distribution(distributions), nil
+}
+
+// KernelList returns slice of all Kernels, requesting all pages of the list
+func (c *Client) KernelList() ([]Kernel, error) {
+	return c.KernelListContext(context.Background())
+}
+
+// KernelListContext is like KernelList, with ctx to cancel the API requests
+func (c *Client) KernelListContext(ctx context.Context) ([]Kernel, error) {
+	return c.kernelList(ctx, nil)
+}
+
+// KernelListFiltered returns slice of Kernels, limited to Xen and/or KVM compatible kernels
+// by passing the isXen and isKVM params to the API
+func (c *Client) KernelListFiltered(isXen, isKVM bool) ([]Kernel, error) {
+	return c.KernelListFilteredContext(context.Background(), isXen, isKVM)
+}
+
+// KernelListFilteredContext is like KernelListFiltered, with ctx to cancel the API requests
+func (c *Client) KernelListFilteredContext(ctx context.Context, isXen, isKVM bool) ([]Kernel, error) {
+	return c.kernelList(ctx, map[string]string{"isXen": boolParam(isXen), "isKVM": boolParam(isKVM)})
+}
+
+// kernelList requests the pages of kernels until a page is empty or adds no new kernels, and returns the kernels
+// of all pages, deduplicated by KernelID. Ending the list therefore costs one extra request after the last page,
+// and an API which ignores the page param returns the full list twice.
+func (c *Client) kernelList(ctx context.Context, params map[string]string) ([]Kernel, error) {
+	var kernels []Kernel
+	seen := make(map[int]bool)
+	for page := 1; page <= maxKernelPages; page++ {
+		pageParams := make(map[string]string, len(params)+1)
+		for k, v := range params {
+			pageParams[k] = v
+		}
+		pageParams["page"] = strconv.Itoa(page)
+
+		var pageKernels []Kernel
+		if err := c.doActionContext(ctx, availKernelsAction, pageParams, &pageKernels); err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, k := range pageKernels {
+			if !seen[k.ID] {
+				seen[k.ID] = true
+				kernels = append(kernels, k)
+				added++
+			}
+		}
+		if added == 0 {
+			break
+		}
+	}
+	return kernels, nil
+}
+
+// PlanList returns slice of Plans, sorted by RAM
+func (c *Client) PlanList() ([]Plan, error) {
+	return c.PlanListContext(context.Background())
+}
+
+// PlanListContext is like PlanList, with ctx to cancel the API requests
+func (c *Client) PlanListContext(ctx context.Context) ([]Plan, error) {
+	var plans sortedPlans
+	if err := c.doActionContext(ctx, availLinodePlansAction, nil, &plans); err != nil {
+		return nil, err
+	}
+	sort.Sort(plans)
+
+	return []Plan(plans), nil
+}
+
+// NodeBalancerAvail returns the pricing of NodeBalancers
+func (c *Client) NodeBalancerAvail() (NodeBalancerAvail, error) {
+	return c.NodeBalancerAvailContext(context.Background())
+}
+
+// NodeBalancerAvailContext is like NodeBalancerAvail, with ctx to cancel the API requests
+func (c *Client) NodeBalancerAvailContext(ctx context.Context) (NodeBalancerAvail, error) {
+	var data json.RawMessage
+	if err := c.doActionContext(ctx, availNodeBalancersAction, nil, &data); err != nil {
+		return NodeBalancerAvail{}, err
+	}
+
+	var avail NodeBalancerAvail
+	// DATA is a single object, but is also accepted as an array of one
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		var avails []NodeBalancerAvail
+		if err := json.Unmarshal(data, &avails); err != nil {
+			return NodeBalancerAvail{}, err
+		}
+		if len(avails) == 0 {
+			return NodeBalancerAvail{}, ErrNoResponse
+		}
+		return avails[0], nil
+	}
+	if err := json.Unmarshal(data, &avail); err != nil {
+		return NodeBalancerAvail{}, err
+	}
+	return avail, nil
+}
+
+// Datacenter represents a Linode datacenter as returned by the API
+type Datacenter struct {
+	ID       int    `json:"DATACENTERID"`
+	Location string `json:"LOCATION"`
+	Abbr     string `json:"ABBR"`
+}
+
+// Sort Datacenters by ID
+type sortedDatacenters []Datacenter
+
+func (sorted sortedDatacenters) Len() int {
+	return len(sorted)
+}
+func (sorted sortedDatacenters) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedDatacenters) Less(i, j int) bool {
+	return sorted[i].ID < sorted[j].ID
+}
+
+// Distribution represents a Linode distribution as returned by the API
+type Distribution struct {
+	ID           int        `json:"DISTRIBUTIONID"`
+	Label        string     `json:"LABEL"`
+	Bit64        int        `json:"IS64BIT"`
+	MinImageSize int        `json:"MINIMAGESIZE"`
+	CreateDT     LinodeTime `json:"CREATE_DT"`
+}
+
+// Is64Bit returns true if the distribution is 64 bit
+func (d Distribution) Is64Bit() bool {
+	return d.Bit64 == 1
+}
+
+// Sort Distributions by Label
+type sortedDistributions []Distribution
+
+func (sorted sortedDistributions) Len() int {
+	return len(sorted)
+}
+func (sorted sortedDistributions) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedDistributions) Less(i, j int) bool {
+	return sorted[i].Label < sorted[j].Label
+}
+
+// Kernel represents a Linode kernel as returned by the API
+type Kernel struct {
+	ID    int    `json:"KERNELID"`
+	Label string `json:"LABEL"`
+	Xen   int    `json:"ISXEN"`
+	KVM   int    `json:"ISKVM"`
+}
+
+// IsXen returns true if the kernel is Xen compatible
+func (k Kernel) IsXen() bool {
+	return k.Xen == 1
+}
+
+// IsKVM returns true if the kernel is KVM compatible
+func (k Kernel) IsKVM() bool {
+	return k.KVM == 1
+}
+
+// Plan represents a Linode plan as returned by the API
+type Plan struct {
+	ID     int     `json:"PLANID"`
+	Label  string  `json:"LABEL"`
+	RAM    int     `json:"RAM"`
+	Disk   int     `json:"DISK"`
+	Cores  int     `json:"CORES"`
+	Price  float64 `json:"PRICE"`
+	Hourly float64 `json:"HOURLY"`
+	Xfer   int     `json:"XFER"`
+}
+
+// NodeBalancerAvail represents the pricing of NodeBalancers as returned by the API
+type NodeBalancerAvail struct {
+	Price  float64 `json:"PRICE"`
+	Hourly float64 `json:"HOURLY"`
+}
+
+// Sort Plans by RAM
+type sortedPlans []Plan
+
+func (sorted sortedPlans) Len() int {
+	return len(sorted)
+}
+func (sorted sortedPlans) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedPlans) Less(i, j int) bool {
+	return sorted[i].RAM < sorted[j].RAM
+}
@@ -0,0 +1,164 @@
+package linode
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDatacenterList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"LOCATION":"Fremont, CA, USA","DATACENTERID":3,"ABBR":"fremont"},{"LOCATION":"Dallas, TX, USA","DATACENTERID":2,"ABBR":"dallas"}],"ACTION":"avail.datacenters"}]`)
+	defer server.Close()
+
+	datacenters, err := c.DatacenterList()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := []Datacenter{
+		{ID: 2, Location: "Dallas, TX, USA", Abbr: "dallas"},
+		{ID: 3, Location: "Fremont, CA, USA", Abbr: "fremont"},
+	}
+	if len(datacenters) != len(expected) {
+		t.Error("expected", len(expected), "given", len(datacenters))
+		return
+	}
+	for i, d := range expected {
+		if datacenters[i] != d {
+			t.Error("expected", d, "given", datacenters[i])
+		}
+	}
+}
+
+func TestDistributionList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"IS64BIT":0,"LABEL":"Ubuntu 14.04 LTS","MINIMAGESIZE":800,"DISTRIBUTIONID":125,"CREATE_DT":"2014-04-17 12:00:00.0"},{"IS64BIT":1,"LABEL":"Debian 7","MINIMAGESIZE":600,"DISTRIBUTIONID":130,"CREATE_DT":"2013-05-08 12:00:00.0"}],"ACTION":"avail.distributions"}]`)
+	defer server.Close()
+
+	distributions, err := c.DistributionList()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := []struct {
+		id     int
+		label  string
+		is64   bool
+		create string
+	}{
+		{130, "Debian 7", true, "2013-05-08 12:00:00"},
+		{125, "Ubuntu 14.04 LTS", false, "2014-04-17 12:00:00"},
+	}
+	if len(distributions) != len(expected) {
+		t.Error("expected", len(expected), "given", len(distributions))
+		return
+	}
+	for i, d := range expected {
+		given := distributions[i]
+		if given.ID != d.id || given.Label != d.label || given.Is64Bit() != d.is64 || given.CreateDT.Format("2006-01-02 15:04:05") != d.create {
+			t.Error("expected", d, "given", given)
+		}
+	}
+}
+
+func TestPlanList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"CORES":2,"PRICE":40.00,"RAM":4096,"XFER":3000,"PLANID":3,"LABEL":"Linode 4096","DISK":96,"HOURLY":0.06},{"CORES":1,"PRICE":10.00,"RAM":1024,"XFER":2000,"PLANID":1,"LABEL":"Linode 1024","DISK":24,"HOURLY":0.015}],"ACTION":"avail.linodeplans"}]`)
+	defer server.Close()
+
+	plans, err := c.PlanList()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := []Plan{
+		{ID: 1, Label: "Linode 1024", RAM: 1024, Disk: 24, Cores: 1, Price: 10, Hourly: 0.015, Xfer: 2000},
+		{ID: 3, Label: "Linode 4096", RAM: 4096, Disk: 96, Cores: 2, Price: 40, Hourly: 0.06, Xfer: 3000},
+	}
+	if len(plans) != len(expected) {
+		t.Error("expected", len(expected), "given", len(plans))
+		return
+	}
+	for i, p := range expected {
+		if plans[i] != p {
+			t.Error("expected", p, "given", plans[i])
+		}
+	}
+}
+
+func TestNodeBalancerAvail(t *testing.T) {
+	responses := []string{
+		`[{"ERRORARRAY":[],"DATA":{"PRICE":20.00,"HOURLY":0.03},"ACTION":"avail.nodebalancers"}]`,
+		`[{"ERRORARRAY":[],"DATA":[{"PRICE":20.00,"HOURLY":0.03}],"ACTION":"avail.nodebalancers"}]`,
+	}
+	for _, response := range responses {
+		c, server := newTestServerClient(response)
+		avail, err := c.NodeBalancerAvail()
+		server.Close()
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		expected := NodeBalancerAvail{Price: 20, Hourly: 0.03}
+		if avail != expected {
+			t.Error("expected", expected, "given", avail)
+		}
+	}
+}
+
+func TestKernelListFiltered(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"LABEL":"Latest 64 bit","ISXEN":0,"ISKVM":1,"KERNELID":138}],"ACTION":"avail.kernels"}]`)
+	defer server.Close()
+
+	kernels, err := c.KernelListFiltered(false, true)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	// the last requested page, which added no new kernels
+	expected := `[{"api_action":"avail.kernels","isKVM":"1","isXen":"0","page":"2"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if len(kernels) != 1 || !kernels[0].IsKVM() || kernels[0].IsXen() {
+		t.Error("unexpected kernels", kernels)
+	}
+}
+
+func TestKernelListPages(t *testing.T) {
+	pages := map[string]string{
+		"1": `[{"ERRORARRAY":[],"DATA":[{"LABEL":"Latest 64 bit","KERNELID":138},{"LABEL":"Latest 32 bit","KERNELID":137}],"ACTION":"avail.kernels"}]`,
+		"2": `[{"ERRORARRAY":[],"DATA":[{"LABEL":"Latest 32 bit","KERNELID":137},{"LABEL":"GRUB 2","KERNELID":210}],"ACTION":"avail.kernels"}]`,
+		"3": `[{"ERRORARRAY":[],"DATA":[],"ACTION":"avail.kernels"}]`,
+	}
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var actions []map[string]string
+		if err := json.Unmarshal([]byte(r.FormValue("api_requestArray")), &actions); err != nil || len(actions) != 1 {
+			t.Error("unexpected request array", r.FormValue("api_requestArray"))
+			return
+		}
+		page := actions[0]["page"]
+		requested = append(requested, page)
+		writeJSON(w, pages[page])
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	kernels, err := c.KernelList()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := []int{138, 137, 210}
+	if len(kernels) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(kernels))
+	}
+	for i, id := range expected {
+		if kernels[i].ID != id {
+			t.Error("expected", id, "given", kernels[i].ID)
+		}
+	}
+	if expectedPages := []string{"1", "2", "3"}; !reflect.DeepEqual(requested, expectedPages) {
+		t.Error("expected", expectedPages, "given", requested)
+	}
+}
@@ -0,0 +1,87 @@
+package linode
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	linodeBackupListAction    = "linode.backup.list"
+	linodeBackupTakeAction    = "linode.backup.take"
+	linodeBackupRestoreAction = "linode.backup.restore"
+)
+
+// BackupList returns slice of the Backups of the given Linode
+func (c *Client) BackupList(linodeID int) ([]Backup, error) {
+	return c.BackupListContext(context.Background(), linodeID)
+}
+
+// BackupListContext is like BackupList, with ctx to cancel the API requests
+func (c *Client) BackupListContext(ctx context.Context, linodeID int) ([]Backup, error) {
+	var backups []Backup
+	if err := c.doActionContext(ctx, linodeBackupListAction, map[string]string{"LinodeID": strconv.Itoa(linodeID)}, &backups); err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+// TakeSnapshot takes a snapshot backup of the Linode with the given label, and returns the JobID of the snapshot job.
+// Backups must be enabled for the Linode, otherwise the error reported by the API is returned as APIErrors;
+// use errors.As with an APIError to inspect its code.
+func (c *Client) TakeSnapshot(linodeID int, label string) (int, error) {
+	return c.TakeSnapshotContext(context.Background(), linodeID, label)
+}
+
+// TakeSnapshotContext is like TakeSnapshot, with ctx to cancel the API requests
+func (c *Client) TakeSnapshotContext(ctx context.Context, linodeID int, label string) (int, error) {
+	params := map[string]string{
+		"LinodeID": strconv.Itoa(linodeID),
+		"Label":    label,
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeBackupTakeAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// RestoreBackup restores a backup of the Linode to targetLinodeID, and returns the JobID of the restore job.
+// If targetLinodeID is 0, the backup is restored to the Linode itself. If overwrite is true, the disks and configs
+// of the target Linode are replaced.
+func (c *Client) RestoreBackup(linodeID, backupID, targetLinodeID int, overwrite bool) (int, error) {
+	return c.RestoreBackupContext(context.Background(), linodeID, backupID, targetLinodeID, overwrite)
+}
+
+// RestoreBackupContext is like RestoreBackup, with ctx to cancel the API requests
+func (c *Client) RestoreBackupContext(ctx context.Context, linodeID, backupID, targetLinodeID int, overwrite bool) (int, error) {
+	if targetLinodeID == 0 {
+		targetLinodeID = linodeID
+	}
+	params := map[string]string{
+		"LinodeID":       strconv.Itoa(linodeID),
+		"BackupID":       strconv.Itoa(backupID),
+		"TargetLinodeID": strconv.Itoa(targetLinodeID),
+		"overwrite":      boolParam(overwrite),
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeBackupRestoreAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// Backup represents a Linode backup as returned by the API
+type Backup struct {
+	ID       int        `json:"BACKUPID"`
+	LinodeID int        `json:"LINODEID"`
+	Label    string     `json:"LABEL"`
+	Type     string     `json:"TYPE"`
+	Status   string     `json:"STATUS"`
+	Created  LinodeTime `json:"CREATED"`
+	Finished LinodeTime `json:"FINISHED"`
+}
+
+// IsAuto returns true if the backup was taken automatically, rather than being a snapshot
+func (b Backup) IsAuto() bool {
+	return b.Type == "auto"
+}
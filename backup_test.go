@@ -0,0 +1,104 @@
+package linode
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackupList(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"BACKUPID":1,"LINODEID":8098,"LABEL":"","TYPE":"auto","STATUS":"successful","CREATED":"2015-09-22 02:00:00.0","FINISHED":"2015-09-22 02:10:00.0"},{"BACKUPID":2,"LINODEID":8098,"LABEL":"before upgrade","TYPE":"snapshot","STATUS":"pending","CREATED":"2015-09-23 11:33:06.0","FINISHED":""}],"ACTION":"linode.backup.list"}]`)
+	defer server.Close()
+
+	backups, err := c.BackupList(8098)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"LinodeID":"8098","api_action":"linode.backup.list"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := []Backup{
+		{
+			ID:       1,
+			LinodeID: 8098,
+			Type:     "auto",
+			Status:   "successful",
+			Created:  LinodeTime{time.Date(2015, 9, 22, 2, 0, 0, 0, time.UTC)},
+			Finished: LinodeTime{time.Date(2015, 9, 22, 2, 10, 0, 0, time.UTC)},
+		},
+		{
+			ID:       2,
+			LinodeID: 8098,
+			Label:    "before upgrade",
+			Type:     "snapshot",
+			Status:   "pending",
+			Created:  LinodeTime{time.Date(2015, 9, 23, 11, 33, 6, 0, time.UTC)},
+		},
+	}
+	if len(backups) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(backups))
+	}
+	for i, b := range expected {
+		if backups[i] != b {
+			t.Error("expected", b, "given", backups[i])
+		}
+	}
+	if !backups[0].IsAuto() || backups[1].IsAuto() {
+		t.Error("unexpected IsAuto", backups)
+	}
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1300},"ACTION":"linode.backup.take"}]`)
+	defer server.Close()
+
+	jobID, err := c.TakeSnapshot(8098, "before upgrade")
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"Label":"before upgrade","LinodeID":"8098","api_action":"linode.backup.take"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	if jobID != 1300 {
+		t.Error("expected", 1300, "given", jobID)
+	}
+}
+
+func TestTakeSnapshotBackupsDisabled(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[{"ERRORCODE":8,"ERRORMESSAGE":"Backups are not enabled for this Linode"}],"DATA":{},"ACTION":"linode.backup.take"}]`)
+	defer server.Close()
+
+	_, err := c.TakeSnapshot(8098, "before upgrade")
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 8 {
+		t.Error("expected API error code", 8, "given", err)
+	}
+}
+
+func TestRestoreBackup(t *testing.T) {
+	cases := []struct {
+		targetLinodeID int
+		overwrite      bool
+		expected       string
+	}{
+		{0, false, `[{"BackupID":"12","LinodeID":"8098","TargetLinodeID":"8098","api_action":"linode.backup.restore","overwrite":"0"}]`},
+		{8099, true, `[{"BackupID":"12","LinodeID":"8098","TargetLinodeID":"8099","api_action":"linode.backup.restore","overwrite":"1"}]`},
+	}
+	for _, testCase := range cases {
+		c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1301},"ACTION":"linode.backup.restore"}]`)
+		jobID, err := c.RestoreBackup(8098, 12, testCase.targetLinodeID, testCase.overwrite)
+		server.Close()
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != testCase.expected {
+			t.Error("expected", testCase.expected, "given", *requestArray)
+		}
+		if jobID != 1301 {
+			t.Error("expected", 1301, "given", jobID)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package linode
+
+import (
+	"context"
+	"sort"
+	"strconv"
+)
+
+const (
+	linodeConfigListAction   = "linode.config.list"
+	linodeConfigCreateAction = "linode.config.create"
+)
+
+// ConfigList returns mapping of LinodeID to slice of its Configs, sorted by ConfigID
+func (c *Client) ConfigList(linodeIDs []int) (map[int][]Config, error) {
+	return c.ConfigListContext(context.Background(), linodeIDs)
+}
+
+// ConfigListContext is like ConfigList, with ctx to cancel the API requests
+func (c *Client) ConfigListContext(ctx context.Context, linodeIDs []int) (map[int][]Config, error) {
+	responses, err := c.doBatchActions(ctx, linodeConfigListAction, "LinodeID", linodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int][]Config, len(responses))
+	for _, r := range responses {
+		var configs sortedConfigs
+		if err = unmarshalData(r.Data, &configs); err != nil {
+			return nil, err
+		}
+		if len(configs) > 0 {
+			sort.Sort(configs)
+			m[configs[0].LinodeID] = []Config(configs)
+		}
+	}
+
+	return m, nil
+}
+
+// CreateConfig creates a configuration profile and returns its ConfigID.
+// diskList is the comma separated list of DiskIDs, in device order. extra holds optional params, such as RootDeviceNum.
+func (c *Client) CreateConfig(linodeID, kernelID int, label string, diskList string, extra map[string]string) (int, error) {
+	return c.CreateConfigContext(context.Background(), linodeID, kernelID, label, diskList, extra)
+}
+
+// CreateConfigContext is like CreateConfig, with ctx to cancel the API requests
+func (c *Client) CreateConfigContext(ctx context.Context, linodeID, kernelID int, label string, diskList string, extra map[string]string) (int, error) {
+	params := make(map[string]string, len(extra)+4)
+	for k, v := range extra {
+		params[k] = v
+	}
+	params["LinodeID"] = strconv.Itoa(linodeID)
+	params["KernelID"] = strconv.Itoa(kernelID)
+	params["Label"] = label
+	params["DiskList"] = diskList
+
+	var data struct {
+		ConfigID int `json:"ConfigID"`
+	}
+	if err := c.doActionContext(ctx, linodeConfigCreateAction, params, &data); err != nil {
+		return 0, err
+	}
+	return data.ConfigID, nil
+}
+
+// Config represents a Linode configuration profile as returned by the API
+type Config struct {
+	ID       int    `json:"ConfigID"`
+	LinodeID int    `json:"LinodeID"`
+	Label    string `json:"Label"`
+	KernelID int    `json:"KernelID"`
+	DiskList string `json:"DiskList"`
+	RunLevel string `json:"RunLevel"`
+}
+
+// Sort Configs by ID
+type sortedConfigs []Config
+
+func (sorted sortedConfigs) Len() int {
+	return len(sorted)
+}
+func (sorted sortedConfigs) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedConfigs) Less(i, j int) bool {
+	return sorted[i].ID < sorted[j].ID
+}
@@ -0,0 +1,68 @@
+package linode
+
+import "testing"
+
+func TestConfigList(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"ConfigID":32,"LinodeID":8098,"Label":"Rescue","KernelID":138,"DiskList":"55319,,,,,,,,","RunLevel":"single"},{"ConfigID":31,"LinodeID":8098,"Label":"Debian","KernelID":138,"DiskList":"55319,55320,,,,,,,","RunLevel":"default"}],"ACTION":"linode.config.list"},{"ERRORARRAY":[],"DATA":[{"ConfigID":40,"LinodeID":8099,"Label":"Ubuntu","KernelID":210,"DiskList":"55400,,,,,,,,","RunLevel":"default"}],"ACTION":"linode.config.list"},{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.config.list"}]`)
+	defer server.Close()
+
+	configs, err := c.ConfigList([]int{8098, 8099, 8100})
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"LinodeID":"8098","api_action":"linode.config.list"},{"LinodeID":"8099","api_action":"linode.config.list"},{"LinodeID":"8100","api_action":"linode.config.list"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := map[int][]Config{
+		8098: {
+			{ID: 31, LinodeID: 8098, Label: "Debian", KernelID: 138, DiskList: "55319,55320,,,,,,,", RunLevel: "default"},
+			{ID: 32, LinodeID: 8098, Label: "Rescue", KernelID: 138, DiskList: "55319,,,,,,,,", RunLevel: "single"},
+		},
+		8099: {
+			{ID: 40, LinodeID: 8099, Label: "Ubuntu", KernelID: 210, DiskList: "55400,,,,,,,,", RunLevel: "default"},
+		},
+	}
+	if len(configs) != len(expected) {
+		t.Error("expected", len(expected), "given", len(configs))
+	}
+	for linodeID, linodeConfigs := range expected {
+		if len(configs[linodeID]) != len(linodeConfigs) {
+			t.Error("expected", linodeConfigs, "given", configs[linodeID])
+			continue
+		}
+		for i, config := range linodeConfigs {
+			if configs[linodeID][i] != config {
+				t.Error("expected", config, "given", configs[linodeID][i])
+			}
+		}
+	}
+}
+
+func TestCreateConfig(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"ConfigID":31},"ACTION":"linode.config.create"}]`)
+	defer server.Close()
+
+	extra := map[string]string{
+		"RootDeviceNum": "2",
+		"LinodeID":      "1",
+		"KernelID":      "1",
+		"Label":         "overridden",
+		"DiskList":      "1",
+	}
+	configID, err := c.CreateConfig(8098, 138, "Debian", "55319,55320", extra)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"DiskList":"55319,55320","KernelID":"138","Label":"Debian","LinodeID":"8098","RootDeviceNum":"2","api_action":"linode.config.create"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	if configID != 31 {
+		t.Error("expected", 31, "given", configID)
+	}
+	if extra["LinodeID"] != "1" {
+		t.Error("expected extra not to be modified, given", extra)
+	}
+}
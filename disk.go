@@ -0,0 +1,142 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	linodeDiskListAction   = "linode.disk.list"
+	linodeDiskCreateAction = "linode.disk.create"
+	linodeDiskDeleteAction = "linode.disk.delete"
+	linodeDiskResizeAction = "linode.disk.resize"
+)
+
+// DiskList returns mapping of LinodeID to slice of its Disks, sorted by DiskID
+func (c *Client) DiskList(linodeIDs []int) (map[int][]Disk, error) {
+	return c.DiskListContext(context.Background(), linodeIDs)
+}
+
+// DiskListContext is like DiskList, with ctx to cancel the API requests
+func (c *Client) DiskListContext(ctx context.Context, linodeIDs []int) (map[int][]Disk, error) {
+	responses, err := c.doBatchActions(ctx, linodeDiskListAction, "LinodeID", linodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int][]Disk, len(responses))
+	for _, r := range responses {
+		var disks sortedDisks
+		if err = unmarshalData(r.Data, &disks); err != nil {
+			return nil, err
+		}
+		if len(disks) > 0 {
+			sort.Sort(disks)
+			m[disks[0].LinodeID] = []Disk(disks)
+		}
+	}
+
+	return m, nil
+}
+
+// CreateDisk creates a disk and returns its DiskID and the JobID of the creation job.
+// diskType is one of ext3, ext4, swap or raw, and size is in MB.
+func (c *Client) CreateDisk(linodeID int, label, diskType string, size int) (int, int, error) {
+	return c.CreateDiskContext(context.Background(), linodeID, label, diskType, size)
+}
+
+// CreateDiskContext is like CreateDisk, with ctx to cancel the API requests
+func (c *Client) CreateDiskContext(ctx context.Context, linodeID int, label, diskType string, size int) (int, int, error) {
+	switch diskType {
+	case "ext3", "ext4", "swap", "raw":
+	default:
+		return 0, 0, fmt.Errorf("invalid disk type %q", diskType)
+	}
+	if size <= 0 {
+		return 0, 0, fmt.Errorf("invalid disk size %d: must be greater than 0", size)
+	}
+	params := map[string]string{
+		"LinodeID": strconv.Itoa(linodeID),
+		"Label":    label,
+		"Type":     diskType,
+		"Size":     strconv.Itoa(size),
+	}
+	var data struct {
+		jobResponse
+		DiskID int `json:"DiskID"`
+	}
+	if err := c.doActionContext(ctx, linodeDiskCreateAction, params, &data); err != nil {
+		return 0, 0, err
+	}
+	return data.DiskID, data.JobID, nil
+}
+
+// DeleteDisk deletes a disk and returns the JobID of the deletion job
+func (c *Client) DeleteDisk(linodeID, diskID int) (int, error) {
+	return c.DeleteDiskContext(context.Background(), linodeID, diskID)
+}
+
+// DeleteDiskContext is like DeleteDisk, with ctx to cancel the API requests
+func (c *Client) DeleteDiskContext(ctx context.Context, linodeID, diskID int) (int, error) {
+	params := map[string]string{
+		"LinodeID": strconv.Itoa(linodeID),
+		"DiskID":   strconv.Itoa(diskID),
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeDiskDeleteAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// ResizeDisk resizes a disk to size MB and returns the JobID of the resize job.
+// The Linode must be powered off; otherwise the error reported by the API is returned as APIErrors.
+func (c *Client) ResizeDisk(linodeID, diskID, size int) (int, error) {
+	return c.ResizeDiskContext(context.Background(), linodeID, diskID, size)
+}
+
+// ResizeDiskContext is like ResizeDisk, with ctx to cancel the API requests
+func (c *Client) ResizeDiskContext(ctx context.Context, linodeID, diskID, size int) (int, error) {
+	params := map[string]string{
+		"LinodeID": strconv.Itoa(linodeID),
+		"DiskID":   strconv.Itoa(diskID),
+		"size":     strconv.Itoa(size),
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeDiskResizeAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// Disk represents a Linode disk as returned by the API
+type Disk struct {
+	ID       int    `json:"DISKID"`
+	LinodeID int    `json:"LINODEID"`
+	Label    string `json:"LABEL"`
+	Type     string `json:"TYPE"`
+	Size     int    `json:"SIZE"`
+	Status   int    `json:"STATUS"`
+	ReadOnly int    `json:"ISREADONLY"`
+}
+
+// IsReadOnly returns true if the disk is read only
+func (d Disk) IsReadOnly() bool {
+	return d.ReadOnly == 1
+}
+
+// Sort Disks by ID
+type sortedDisks []Disk
+
+func (sorted sortedDisks) Len() int {
+	return len(sorted)
+}
+func (sorted sortedDisks) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedDisks) Less(i, j int) bool {
+	return sorted[i].ID < sorted[j].ID
+}
@@ -0,0 +1,102 @@
+package linode
+
+import "testing"
+
+func TestDiskList(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"DISKID":55320,"LINODEID":8098,"LABEL":"swap","TYPE":"swap","SIZE":256,"STATUS":1,"ISREADONLY":0},{"DISKID":55319,"LINODEID":8098,"LABEL":"root","TYPE":"ext4","SIZE":40704,"STATUS":1,"ISREADONLY":0}],"ACTION":"linode.disk.list"},{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.disk.list"},{"ERRORARRAY":[],"DATA":[{"DISKID":55400,"LINODEID":8099,"LABEL":"data","TYPE":"raw","SIZE":1024,"STATUS":1,"ISREADONLY":1}],"ACTION":"linode.disk.list"}]`)
+	defer server.Close()
+
+	disks, err := c.DiskList([]int{8098, 8100, 8099})
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"LinodeID":"8098","api_action":"linode.disk.list"},{"LinodeID":"8100","api_action":"linode.disk.list"},{"LinodeID":"8099","api_action":"linode.disk.list"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := map[int][]Disk{
+		8098: {
+			{ID: 55319, LinodeID: 8098, Label: "root", Type: "ext4", Size: 40704, Status: 1},
+			{ID: 55320, LinodeID: 8098, Label: "swap", Type: "swap", Size: 256, Status: 1},
+		},
+		8099: {
+			{ID: 55400, LinodeID: 8099, Label: "data", Type: "raw", Size: 1024, Status: 1, ReadOnly: 1},
+		},
+	}
+	if len(disks) != len(expected) {
+		t.Error("expected", len(expected), "given", len(disks))
+	}
+	for linodeID, linodeDisks := range expected {
+		if len(disks[linodeID]) != len(linodeDisks) {
+			t.Error("expected", linodeDisks, "given", disks[linodeID])
+			continue
+		}
+		for i, disk := range linodeDisks {
+			if disks[linodeID][i] != disk {
+				t.Error("expected", disk, "given", disks[linodeID][i])
+			}
+		}
+	}
+	if !disks[8099][0].IsReadOnly() {
+		t.Error("expected disk to be read only", disks[8099][0])
+	}
+}
+
+func TestCreateDisk(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1298,"DiskID":55647},"ACTION":"linode.disk.create"}]`)
+	defer server.Close()
+
+	diskID, jobID, err := c.CreateDisk(8098, "root", "ext4", 1024)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := `[{"Label":"root","LinodeID":"8098","Size":"1024","Type":"ext4","api_action":"linode.disk.create"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if diskID != 55647 || jobID != 1298 {
+		t.Error("expected", 55647, 1298, "given", diskID, jobID)
+	}
+
+	if _, _, err = c.CreateDisk(8098, "root", "ext4", 0); err == nil {
+		t.Error("expected error for invalid size")
+	}
+}
+
+func TestDeleteDisk(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1299,"DiskID":55647},"ACTION":"linode.disk.delete"}]`)
+	defer server.Close()
+
+	jobID, err := c.DeleteDisk(8098, 55647)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := `[{"DiskID":"55647","LinodeID":"8098","api_action":"linode.disk.delete"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if jobID != 1299 {
+		t.Error("expected", 1299, "given", jobID)
+	}
+}
+
+func TestResizeDisk(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1300,"DiskID":55647},"ACTION":"linode.disk.resize"}]`)
+	defer server.Close()
+
+	jobID, err := c.ResizeDisk(8098, 55647, 2048)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := `[{"DiskID":"55647","LinodeID":"8098","api_action":"linode.disk.resize","size":"2048"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if jobID != 1300 {
+		t.Error("expected", 1300, "given", jobID)
+	}
+}
@@ -0,0 +1,129 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	domainListAction   = "domain.list"
+	domainCreateAction = "domain.create"
+	domainUpdateAction = "domain.update"
+)
+
+// DomainList returns slice of Domains, sorted by Domain name
+func (c *Client) DomainList() ([]Domain, error) {
+	return c.DomainListContext(context.Background())
+}
+
+// DomainListContext is like DomainList, with ctx to cancel the API requests
+func (c *Client) DomainListContext(ctx context.Context) ([]Domain, error) {
+	var domains sortedDomains
+	if err := c.doActionContext(ctx, domainListAction, nil, &domains); err != nil {
+		return nil, err
+	}
+	sort.Sort(domains)
+
+	return []Domain(domains), nil
+}
+
+// CreateDomain creates a DNS zone and returns its DomainID. domainType is master or slave; soaEmail is required
+// for master zones. Errors reported by the API, such as for an already existing zone, are returned as APIErrors.
+func (c *Client) CreateDomain(domain, soaEmail, domainType string) (int, error) {
+	return c.CreateDomainContext(context.Background(), domain, soaEmail, domainType)
+}
+
+// CreateDomainContext is like CreateDomain, with ctx to cancel the API requests
+func (c *Client) CreateDomainContext(ctx context.Context, domain, soaEmail, domainType string) (int, error) {
+	if domain == "" {
+		return 0, fmt.Errorf("invalid domain: must not be empty")
+	}
+	switch domainType {
+	case "master":
+		if soaEmail == "" {
+			return 0, fmt.Errorf("invalid SOA email: required for master domains")
+		}
+	case "slave":
+	default:
+		return 0, fmt.Errorf("invalid domain type %q", domainType)
+	}
+	params := map[string]string{
+		"Domain": domain,
+		"Type":   domainType,
+	}
+	if soaEmail != "" {
+		params["SOA_Email"] = soaEmail
+	}
+	var data domainIDResponse
+	if err := c.doActionContext(ctx, domainCreateAction, params, &data); err != nil {
+		return 0, err
+	}
+	return data.DomainID, nil
+}
+
+// UpdateDomain updates the given fields of a DNS zone, e.g. {"SOA_Email": "hostmaster@example.com"}.
+// The field names are the params of the domain.update API method.
+func (c *Client) UpdateDomain(domainID int, fields map[string]string) error {
+	return c.UpdateDomainContext(context.Background(), domainID, fields)
+}
+
+// UpdateDomainContext is like UpdateDomain, with ctx to cancel the API requests
+func (c *Client) UpdateDomainContext(ctx context.Context, domainID int, fields map[string]string) error {
+	if domainID <= 0 {
+		return fmt.Errorf("invalid domain ID %d: must be greater than 0", domainID)
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("no fields to update")
+	}
+	params := make(map[string]string, len(fields)+1)
+	for k, v := range fields {
+		params[k] = v
+	}
+	params["DomainID"] = strconv.Itoa(domainID)
+	var data domainIDResponse
+	return c.doActionContext(ctx, domainUpdateAction, params, &data)
+}
+
+// domainIDResponse is the 'DATA' of actions which create or modify a Domain
+type domainIDResponse struct {
+	DomainID int `json:"DomainID"`
+}
+
+// Domain represents a DNS zone as returned by the API
+type Domain struct {
+	ID        int    `json:"DOMAINID"`
+	Domain    string `json:"DOMAIN"`
+	Type      string `json:"TYPE"`
+	SOAEmail  string `json:"SOA_EMAIL"`
+	Status    int    `json:"STATUS"`
+	MasterIPs string `json:"MASTER_IPS"`
+}
+
+// StatusString returns a human readable Status
+func (d Domain) StatusString() string {
+	switch d.Status {
+	case 0:
+		return "Disabled"
+	case 1:
+		return "Active"
+	case 2:
+		return "Edit Mode"
+	}
+	return fmt.Sprintf("Unknown (%d)", d.Status)
+}
+
+// Sort Domains by Domain name
+type sortedDomains []Domain
+
+func (sorted sortedDomains) Len() int {
+	return len(sorted)
+}
+func (sorted sortedDomains) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedDomains) Less(i, j int) bool {
+	return sorted[i].Domain < sorted[j].Domain
+}
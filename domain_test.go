@@ -0,0 +1,110 @@
+package linode
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDomainList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"DOMAINID":5094,"DOMAIN":"example.org","TYPE":"slave","STATUS":1,"SOA_EMAIL":"","MASTER_IPS":"10.0.0.1;"},{"DOMAINID":5093,"DOMAIN":"example.com","TYPE":"master","STATUS":2,"SOA_EMAIL":"hostmaster@example.com","MASTER_IPS":""}],"ACTION":"domain.list"}]`)
+	defer server.Close()
+
+	domains, err := c.DomainList()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := []Domain{
+		{ID: 5093, Domain: "example.com", Type: "master", SOAEmail: "hostmaster@example.com", Status: 2},
+		{ID: 5094, Domain: "example.org", Type: "slave", Status: 1, MasterIPs: "10.0.0.1;"},
+	}
+	if len(domains) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(domains))
+	}
+	for i, d := range expected {
+		if domains[i] != d {
+			t.Error("expected", d, "given", domains[i])
+		}
+	}
+}
+
+func TestDomainStatusString(t *testing.T) {
+	cases := []struct {
+		status   int
+		expected string
+	}{
+		{0, "Disabled"},
+		{1, "Active"},
+		{2, "Edit Mode"},
+		{3, "Unknown (3)"},
+	}
+	for _, testCase := range cases {
+		given := Domain{Status: testCase.status}.StatusString()
+		if given != testCase.expected {
+			t.Error("expected", testCase.expected, "given", given)
+		}
+	}
+}
+
+func TestCreateDomain(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"DomainID":5093},"ACTION":"domain.create"}]`)
+	defer server.Close()
+
+	domainID, err := c.CreateDomain("example.com", "hostmaster@example.com", "master")
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"Domain":"example.com","SOA_Email":"hostmaster@example.com","Type":"master","api_action":"domain.create"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	if domainID != 5093 {
+		t.Error("expected", 5093, "given", domainID)
+	}
+}
+
+func TestCreateDomainInvalid(t *testing.T) {
+	c := newTestClient()
+	cases := []struct {
+		domain, soaEmail, domainType string
+	}{
+		{"", "hostmaster@example.com", "master"},
+		{"example.com", "", "master"},
+		{"example.com", "hostmaster@example.com", "primary"},
+	}
+	for _, testCase := range cases {
+		if _, err := c.CreateDomain(testCase.domain, testCase.soaEmail, testCase.domainType); err == nil {
+			t.Error("expected error for", testCase)
+		}
+	}
+}
+
+func TestCreateDomainDuplicate(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[{"ERRORCODE":8,"ERRORMESSAGE":"The domain 'example.com' already exists"}],"DATA":{},"ACTION":"domain.create"}]`)
+	defer server.Close()
+
+	_, err := c.CreateDomain("example.com", "hostmaster@example.com", "master")
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 8 {
+		t.Error("expected API error code", 8, "given", err)
+	}
+}
+
+func TestUpdateDomain(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"DomainID":5093},"ACTION":"domain.update"}]`)
+	defer server.Close()
+
+	if err := c.UpdateDomain(5093, map[string]string{"SOA_Email": "dns@example.com"}); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"DomainID":"5093","SOA_Email":"dns@example.com","api_action":"domain.update"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+
+	if err := c.UpdateDomain(0, map[string]string{"SOA_Email": "dns@example.com"}); err == nil {
+		t.Error("expected error for invalid domain ID")
+	}
+	if err := c.UpdateDomain(5093, nil); err == nil {
+		t.Error("expected error for no fields")
+	}
+}
@@ -0,0 +1,34 @@
+package linode
+
+import "context"
+
+const (
+	testEchoAction = "test.echo"
+)
+
+// Echo sends params to the test.echo action and returns the params echoed back by the API.
+// It does not modify anything, which makes it useful to check connectivity.
+func (c *Client) Echo(params map[string]string) (map[string]string, error) {
+	return c.EchoContext(context.Background(), params)
+}
+
+// EchoContext is like Echo, with ctx to cancel the API requests
+func (c *Client) EchoContext(ctx context.Context, params map[string]string) (map[string]string, error) {
+	echoed := make(map[string]string)
+	if err := c.doActionContext(ctx, testEchoAction, params, &echoed); err != nil {
+		return nil, err
+	}
+	return echoed, nil
+}
+
+// Validate checks that the client's API key is valid by issuing a test.echo action.
+// An invalid key results in APIErrors with code 4.
+func (c *Client) Validate() error {
+	return c.ValidateContext(context.Background())
+}
+
+// ValidateContext is like Validate, with ctx to cancel the API requests
+func (c *Client) ValidateContext(ctx context.Context) error {
+	_, err := c.EchoContext(ctx, nil)
+	return err
+}
@@ -0,0 +1,28 @@
+package linode
+
+import "testing"
+
+func TestEcho(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{"foo":"bar"},"ACTION":"test.echo"}]`)
+	defer server.Close()
+
+	echoed, err := c.Echo(map[string]string{"foo": "bar"})
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if echoed["foo"] != "bar" {
+		t.Error("expected", "bar", "given", echoed["foo"])
+	}
+}
+
+func TestValidate(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[{"ERRORCODE":4,"ERRORMESSAGE":"Authentication failed"}],"DATA":{},"ACTION":"test.echo"}]`)
+	defer server.Close()
+
+	err := c.Validate()
+	apiErrs, ok := err.(APIErrors)
+	if !ok || len(apiErrs) != 1 || apiErrs[0].Code != 4 {
+		t.Error("expected authentication APIErrors, given", err)
+	}
+}
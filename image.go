@@ -0,0 +1,68 @@
+package linode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+const (
+	imageListAction = "image.list"
+)
+
+// ImageList returns slice of Images, sorted by CreateDT with the newest first
+func (c *Client) ImageList() ([]Image, error) {
+	return c.ImageListContext(context.Background())
+}
+
+// ImageListContext is like ImageList, with ctx to cancel the API requests
+func (c *Client) ImageListContext(ctx context.Context) ([]Image, error) {
+	var data json.RawMessage
+	if err := c.doActionContext(ctx, imageListAction, nil, &data); err != nil {
+		return nil, err
+	}
+
+	var images sortedImages
+	// a single image may be returned as an object rather than an array
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '{' {
+		var image Image
+		if err := json.Unmarshal(data, &image); err != nil {
+			return nil, err
+		}
+		if image.ID != 0 {
+			images = append(images, image)
+		}
+	} else if err := json.Unmarshal(data, &images); err != nil {
+		return nil, err
+	}
+	sort.Sort(images)
+
+	return []Image(images), nil
+}
+
+// Image represents a saved disk image as returned by the API
+type Image struct {
+	ID          int        `json:"IMAGEID"`
+	Label       string     `json:"LABEL"`
+	Status      string     `json:"STATUS"`
+	Creator     string     `json:"CREATOR"`
+	Type        string     `json:"TYPE"`
+	MinSize     int        `json:"MINSIZE"`
+	CreateDT    LinodeTime `json:"CREATE_DT"`
+	Description string     `json:"DESCRIPTION"`
+}
+
+// Sort Images by CreateDT, newest first
+type sortedImages []Image
+
+func (sorted sortedImages) Len() int {
+	return len(sorted)
+}
+func (sorted sortedImages) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedImages) Less(i, j int) bool {
+	return sorted[i].CreateDT.After(sorted[j].CreateDT.Time)
+}
@@ -0,0 +1,32 @@
+package linode
+
+import "testing"
+
+func TestImageList(t *testing.T) {
+	cases := []struct {
+		data     string
+		expected []int
+	}{
+		{`[{"IMAGEID":1,"CREATE_DT":"2014-05-05 14:29:31.0"},{"IMAGEID":2,"CREATE_DT":"2014-06-05 14:29:31.0"}]`, []int{2, 1}},
+		{`{"IMAGEID":3,"CREATE_DT":"2014-05-05 14:29:31.0"}`, []int{3}},
+		{`{}`, []int{}},
+	}
+	for _, testCase := range cases {
+		c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":` + testCase.data + `,"ACTION":"image.list"}]`)
+		images, err := c.ImageList()
+		server.Close()
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if len(images) != len(testCase.expected) {
+			t.Error("expected", len(testCase.expected), "given", len(images))
+			continue
+		}
+		for i, id := range testCase.expected {
+			if images[i].ID != id {
+				t.Error("expected", id, "given", images[i].ID)
+			}
+		}
+	}
+}
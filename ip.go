@@ -0,0 +1,94 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	linodeIPAddPrivateAction = "linode.ip.addprivate"
+	linodeIPAddPublicAction  = "linode.ip.addpublic"
+	linodeIPSwapAction       = "linode.ip.swap"
+	linodeIPSetRDNSAction    = "linode.ip.setrdns"
+)
+
+// AddPrivateIP assigns a private IP to the Linode and returns it
+func (c *Client) AddPrivateIP(linodeID int) (LinodeIP, error) {
+	return c.AddPrivateIPContext(context.Background(), linodeID)
+}
+
+// AddPrivateIPContext is like AddPrivateIP, with ctx to cancel the API requests
+func (c *Client) AddPrivateIPContext(ctx context.Context, linodeID int) (LinodeIP, error) {
+	return c.addIP(ctx, linodeIPAddPrivateAction, linodeID, 0)
+}
+
+// AddPublicIP assigns an additional public IP to the Linode and returns it.
+// If the account is not allowed additional IPs, the error reported by the API is returned as APIErrors.
+func (c *Client) AddPublicIP(linodeID int) (LinodeIP, error) {
+	return c.AddPublicIPContext(context.Background(), linodeID)
+}
+
+// AddPublicIPContext is like AddPublicIP, with ctx to cancel the API requests
+func (c *Client) AddPublicIPContext(ctx context.Context, linodeID int) (LinodeIP, error) {
+	return c.addIP(ctx, linodeIPAddPublicAction, linodeID, 1)
+}
+
+// SwapIP moves an IP to another Linode. Either the IP is exchanged with withIPAddressID,
+// or moved to toLinodeID; only one of both may be non-zero.
+func (c *Client) SwapIP(ipAddressID, withIPAddressID, toLinodeID int) error {
+	return c.SwapIPContext(context.Background(), ipAddressID, withIPAddressID, toLinodeID)
+}
+
+// SwapIPContext is like SwapIP, with ctx to cancel the API requests
+func (c *Client) SwapIPContext(ctx context.Context, ipAddressID, withIPAddressID, toLinodeID int) error {
+	if withIPAddressID != 0 && toLinodeID != 0 {
+		return fmt.Errorf("invalid IP swap: withIPAddressID %d and toLinodeID %d are mutually exclusive", withIPAddressID, toLinodeID)
+	}
+	params := map[string]string{"IPAddressID": strconv.Itoa(ipAddressID)}
+	if withIPAddressID != 0 {
+		params["withIPAddressID"] = strconv.Itoa(withIPAddressID)
+	}
+	if toLinodeID != 0 {
+		params["toLinodeID"] = strconv.Itoa(toLinodeID)
+	}
+	var data json.RawMessage
+	return c.doActionContext(ctx, linodeIPSwapAction, params, &data)
+}
+
+// SetRDNS sets the reverse DNS name of an IP and returns the updated IP
+func (c *Client) SetRDNS(ipAddressID int, hostname string) (LinodeIP, error) {
+	return c.SetRDNSContext(context.Background(), ipAddressID, hostname)
+}
+
+// SetRDNSContext is like SetRDNS, with ctx to cancel the API requests
+func (c *Client) SetRDNSContext(ctx context.Context, ipAddressID int, hostname string) (LinodeIP, error) {
+	params := map[string]string{
+		"IPAddressID": strconv.Itoa(ipAddressID),
+		"Hostname":    hostname,
+	}
+	// the API returns the reverse DNS name as HOSTNAME rather than RDNS_NAME
+	var data struct {
+		LinodeIP
+		Hostname string `json:"HOSTNAME"`
+	}
+	if err := c.doActionContext(ctx, linodeIPSetRDNSAction, params, &data); err != nil {
+		return LinodeIP{}, err
+	}
+	ip := data.LinodeIP
+	if data.Hostname != "" {
+		ip.RDNS = data.Hostname
+	}
+	return ip, nil
+}
+
+func (c *Client) addIP(ctx context.Context, method string, linodeID int, public int) (LinodeIP, error) {
+	var ip LinodeIP
+	if err := c.doActionContext(ctx, method, map[string]string{"LinodeID": strconv.Itoa(linodeID)}, &ip); err != nil {
+		return LinodeIP{}, err
+	}
+	ip.LinodeID = linodeID
+	ip.Public = public
+	return ip, nil
+}
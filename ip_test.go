@@ -0,0 +1,90 @@
+package linode
+
+import "testing"
+
+func TestAddPrivateIP(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"IPAddressID":5384,"IPAddress":"192.168.100.1"},"ACTION":"linode.ip.addprivate"}]`)
+	defer server.Close()
+
+	ip, err := c.AddPrivateIP(8098)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"LinodeID":"8098","api_action":"linode.ip.addprivate"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := LinodeIP{ID: 5384, LinodeID: 8098, Public: 0, IP: "192.168.100.1"}
+	if ip != expected {
+		t.Error("expected", expected, "given", ip)
+	}
+}
+
+func TestAddPublicIP(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"IPAddressID":5385,"IPAddress":"69.93.1.11"},"ACTION":"linode.ip.addpublic"}]`)
+	defer server.Close()
+
+	ip, err := c.AddPublicIP(8098)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"LinodeID":"8098","api_action":"linode.ip.addpublic"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := LinodeIP{ID: 5385, LinodeID: 8098, Public: 1, IP: "69.93.1.11"}
+	if ip != expected {
+		t.Error("expected", expected, "given", ip)
+	}
+}
+
+func TestSetRDNS(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"HOSTNAME":"host.example.com","IPADDRESS":"69.93.1.10","IPADDRESSID":5384},"ACTION":"linode.ip.setrdns"}]`)
+	defer server.Close()
+
+	ip, err := c.SetRDNS(5384, "host.example.com")
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"Hostname":"host.example.com","IPAddressID":"5384","api_action":"linode.ip.setrdns"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := LinodeIP{ID: 5384, IP: "69.93.1.10", RDNS: "host.example.com"}
+	if ip != expected {
+		t.Error("expected", expected, "given", ip)
+	}
+}
+
+func TestSwapIP(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[],"ACTION":"linode.ip.swap"}]`)
+	defer server.Close()
+
+	tests := []struct {
+		withIPAddressID, toLinodeID int
+		expected                    string
+	}{
+		{5385, 0, `[{"IPAddressID":"5384","api_action":"linode.ip.swap","withIPAddressID":"5385"}]`},
+		{0, 8099, `[{"IPAddressID":"5384","api_action":"linode.ip.swap","toLinodeID":"8099"}]`},
+	}
+	for _, test := range tests {
+		if err := c.SwapIP(5384, test.withIPAddressID, test.toLinodeID); err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != test.expected {
+			t.Error("expected", test.expected, "given", *requestArray)
+		}
+	}
+
+	*requestArray = ""
+	if err := c.SwapIP(5384, 5385, 8099); err == nil {
+		t.Error("expected error when both withIPAddressID and toLinodeID are given")
+	}
+	if *requestArray != "" {
+		t.Error("expected no request, given", *requestArray)
+	}
+}
@@ -0,0 +1,168 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	linodeJobListAction = "linode.job.list"
+)
+
+// JobList returns slice of Jobs of the given Linode. If pendingOnly is true, only unfinished jobs are returned.
+func (c *Client) JobList(linodeID int, pendingOnly bool) ([]Job, error) {
+	return c.JobListContext(context.Background(), linodeID, pendingOnly)
+}
+
+// JobListContext is like JobList, with ctx to cancel the API requests
+func (c *Client) JobListContext(ctx context.Context, linodeID int, pendingOnly bool) ([]Job, error) {
+	params := map[string]string{"LinodeID": strconv.Itoa(linodeID)}
+	if pendingOnly {
+		params["pendingOnly"] = boolParam(pendingOnly)
+	}
+	var jobs []Job
+	if err := c.doActionContext(ctx, linodeJobListAction, params, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// WaitForJob polls the job every poll interval until it is finished, or ctx is done. poll must be greater than 0.
+// The finished Job is returned, along with an error if the job did not succeed.
+func (c *Client) WaitForJob(ctx context.Context, linodeID, jobID int, poll time.Duration) (*Job, error) {
+	return c.WaitForJobOptions(ctx, linodeID, jobID, JobWaitOptions{Poll: poll})
+}
+
+// JobWaitOptions configures the polling of WaitForJobOptions
+type JobWaitOptions struct {
+	// Poll is the interval between the first polls, and must be greater than 0
+	Poll time.Duration
+	// MaxPoll caps the poll interval, which is doubled after each poll. If MaxPoll is not greater than Poll,
+	// the interval is not increased.
+	MaxPoll time.Duration
+	// Timeout is the maximum time to wait for the job to finish. Zero means no timeout.
+	Timeout time.Duration
+
+	clock clock // defaults to the system clock, replaced in tests
+}
+
+// WaitForJobOptions is like WaitForJob, but backs off the poll interval and gives up after a timeout as configured by opts.
+// If the job did not finish in time, the last polled Job is returned along with an error wrapping ErrJobTimeout.
+func (c *Client) WaitForJobOptions(ctx context.Context, linodeID, jobID int, opts JobWaitOptions) (*Job, error) {
+	if opts.Poll <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s: must be greater than 0", opts.Poll)
+	}
+	clk := opts.clock
+	if clk == nil {
+		clk = systemClock{}
+	}
+	var deadline time.Time
+	if opts.Timeout > 0 {
+		deadline = clk.Now().Add(opts.Timeout)
+	}
+	params := map[string]string{
+		"LinodeID": strconv.Itoa(linodeID),
+		"JobID":    strconv.Itoa(jobID),
+	}
+	interval := opts.Poll
+	for {
+		var jobs []Job
+		if err := c.doActionContext(ctx, linodeJobListAction, params, &jobs); err != nil {
+			return nil, err
+		}
+		var job *Job
+		for i := range jobs {
+			if jobs[i].ID == jobID {
+				job = &jobs[i]
+			}
+		}
+		if job == nil {
+			return nil, fmt.Errorf("job %d of linode %d not found", jobID, linodeID)
+		}
+		if job.IsFinished() {
+			if !job.IsSuccess() {
+				return job, fmt.Errorf("job %d (%s) of linode %d failed", jobID, job.Action, linodeID)
+			}
+			return job, nil
+		}
+
+		wait := interval
+		if !deadline.IsZero() {
+			remaining := deadline.Sub(clk.Now())
+			if remaining <= 0 {
+				return job, fmt.Errorf("%w %d (%s) of linode %d after %s", ErrJobTimeout, jobID, job.Action, linodeID, opts.Timeout)
+			}
+			if wait > remaining {
+				wait = remaining
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-clk.After(wait):
+		}
+		if interval *= 2; interval > opts.MaxPoll {
+			interval = opts.MaxPoll
+		}
+		if interval < opts.Poll {
+			interval = opts.Poll
+		}
+	}
+}
+
+// clock abstracts time for WaitForJobOptions
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Job represents a Linode job as returned by the API
+type Job struct {
+	ID           int        `json:"JOBID"`
+	LinodeID     int        `json:"LINODEID"`
+	Action       string     `json:"ACTION"`
+	Label        string     `json:"LABEL"`
+	HostSuccess  int        `json:"HOST_SUCCESS"`
+	HostFinishDT LinodeTime `json:"HOST_FINISH_DT"`
+	EnteredDT    LinodeTime `json:"ENTERED_DT"`
+}
+
+// UnmarshalJSON handles HOST_SUCCESS, which the API returns as an empty string for unfinished jobs
+func (j *Job) UnmarshalJSON(data []byte) error {
+	type job Job // prevent recursion
+	aux := struct {
+		*job
+		HostSuccess interface{} `json:"HOST_SUCCESS"`
+	}{job: (*job)(j)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	j.HostSuccess = 0
+	if v, ok := aux.HostSuccess.(float64); ok {
+		j.HostSuccess = int(v)
+	}
+	return nil
+}
+
+// IsFinished returns true if the host has finished the job
+func (j Job) IsFinished() bool {
+	return !j.HostFinishDT.IsZero()
+}
+
+// IsSuccess returns true if the job finished successfully
+func (j Job) IsSuccess() bool {
+	return j.IsFinished() && j.HostSuccess == 1
+}
@@ -0,0 +1,129 @@
+package linode
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestJobList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"ENTERED_DT":"2009-08-17 06:17:55.0","ACTION":"linode.boot","LABEL":"System Boot","HOST_SUCCESS":"","LINODEID":8098,"HOST_FINISH_DT":"","JOBID":1298},{"ENTERED_DT":"2009-08-16 06:17:55.0","ACTION":"linode.create","LABEL":"Linode Initial Configuration","HOST_SUCCESS":1,"LINODEID":8098,"HOST_FINISH_DT":"2009-08-16 06:18:05.0","JOBID":1297}],"ACTION":"linode.job.list"}]`)
+	defer server.Close()
+
+	jobs, err := c.JobList(8098, false)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if len(jobs) != 2 {
+		t.Error("expected", 2, "given", len(jobs))
+		return
+	}
+	if jobs[0].ID != 1298 || jobs[0].IsFinished() || jobs[0].IsSuccess() {
+		t.Error("unexpected pending job", jobs[0])
+	}
+	if jobs[1].ID != 1297 || !jobs[1].IsFinished() || !jobs[1].IsSuccess() {
+		t.Error("unexpected finished job", jobs[1])
+	}
+}
+
+func TestWaitForJob(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits < 3 {
+			writeJSON(w, `[{"ERRORARRAY":[],"DATA":[{"ACTION":"linode.boot","HOST_SUCCESS":"","LINODEID":8098,"HOST_FINISH_DT":"","JOBID":1298}],"ACTION":"linode.job.list"}]`)
+			return
+		}
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":[{"ACTION":"linode.boot","HOST_SUCCESS":1,"LINODEID":8098,"HOST_FINISH_DT":"2009-08-17 06:18:05.0","JOBID":1298}],"ACTION":"linode.job.list"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	job, err := c.WaitForJob(context.Background(), 8098, 1298, time.Millisecond)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if !job.IsSuccess() {
+		t.Error("expected job to be successful", job)
+	}
+	if hits != 3 {
+		t.Error("expected", 3, "given", hits)
+	}
+}
+
+// fakeClock advances its time by the requested duration on each call to After
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func TestWaitForJobTimeout(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"ACTION":"linode.boot","HOST_SUCCESS":"","LINODEID":8098,"HOST_FINISH_DT":"","JOBID":1298}],"ACTION":"linode.job.list"}]`)
+	defer server.Close()
+
+	clk := &fakeClock{now: time.Date(2015, 9, 22, 11, 33, 6, 0, time.UTC)}
+	opts := JobWaitOptions{Poll: time.Second, MaxPoll: 4 * time.Second, Timeout: 10 * time.Second, clock: clk}
+	job, err := c.WaitForJobOptions(context.Background(), 8098, 1298, opts)
+	if !errors.Is(err, ErrJobTimeout) {
+		t.Error("expected", ErrJobTimeout, "given", err)
+	}
+	if job == nil || job.ID != 1298 {
+		t.Error("expected the pending job, given", job)
+	}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 3 * time.Second}
+	if len(clk.sleeps) != len(expected) {
+		t.Fatal("expected", expected, "given", clk.sleeps)
+	}
+	for i, d := range expected {
+		if clk.sleeps[i] != d {
+			t.Error("expected", d, "given", clk.sleeps[i])
+		}
+	}
+}
+
+func TestWaitForJobInvalidPoll(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":[{"ACTION":"linode.boot","HOST_SUCCESS":"","LINODEID":8098,"HOST_FINISH_DT":"","JOBID":1298}],"ACTION":"linode.job.list"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	for _, poll := range []time.Duration{0, -time.Second} {
+		if _, err := c.WaitForJob(context.Background(), 8098, 1298, poll); err == nil {
+			t.Error("expected error for poll interval", poll)
+		}
+	}
+	if hits != 0 {
+		t.Error("expected", 0, "given", hits)
+	}
+}
+
+func TestWaitForJobCanceled(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"ACTION":"linode.boot","HOST_SUCCESS":"","LINODEID":8098,"HOST_FINISH_DT":"","JOBID":1298}],"ACTION":"linode.job.list"}]`)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.WaitForJob(ctx, 8098, 1298, time.Millisecond); err == nil {
+		t.Error("expected error when the context is done")
+	}
+}
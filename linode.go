@@ -1,64 +1,156 @@
 package linode
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"reflect"
 	"sort"
 	"strconv"
 )
 
 const (
-	linodeListAction   = "linode.list"
-	linodeIPListAction = "linode.ip.list"
+	linodeListAction            = "linode.list"
+	linodeIPListAction          = "linode.ip.list"
+	linodeBootAction            = "linode.boot"
+	linodeShutdownAction        = "linode.shutdown"
+	linodeRebootAction          = "linode.reboot"
+	linodeCreateAction          = "linode.create"
+	linodeDeleteAction          = "linode.delete"
+	linodeResizeAction          = "linode.resize"
+	linodeCloneAction           = "linode.clone"
+	linodeUpdateAction          = "linode.update"
+	linodeWebConsoleTokenAction = "linode.webconsoletoken"
+)
+
+// Errors returned by the higher-level methods, possibly wrapped; use errors.Is to match them.
+var (
+	// ErrNoResponse is returned if the API returned no response for an action
+	ErrNoResponse = errors.New("no response")
+	// ErrUnexpectedResponseCount is returned if the API returned more than one response for an action
+	ErrUnexpectedResponseCount = errors.New("unexpected number of responses")
+	// ErrUnexpectedAction is returned if the API returned a response for another action than requested
+	ErrUnexpectedAction = errors.New("unexpected api action")
+	// ErrNoPublicIP is returned by FirstPublicIP if the Linode has no public IP
+	ErrNoPublicIP = errors.New("no public IP")
+	// ErrLinodeNotFound is returned by LinodeInfo if no Linode has the given ID
+	ErrLinodeNotFound = errors.New("linode not found")
+	// ErrJobTimeout is returned by WaitForJobOptions if the job did not finish within the timeout
+	ErrJobTimeout = errors.New("timeout waiting for job")
 )
 
 // LinodeList returns slice of Linodes
 func (c *Client) LinodeList() ([]Linode, error) {
-	req := c.NewRequest().AddAction(linodeListAction, nil)
-	var err error
+	return c.LinodeListContext(context.Background())
+}
 
-	responses, err := req.GetJSON()
-	if err != nil {
+// LinodeListContext is like LinodeList, with ctx to cancel the API requests
+func (c *Client) LinodeListContext(ctx context.Context) ([]Linode, error) {
+	var linodes sortedLinodes
+	if err := c.doActionContext(ctx, linodeListAction, nil, &linodes); err != nil {
 		return nil, err
 	}
-	if len(responses) != 1 {
-		return nil, fmt.Errorf("unexpected number of responses: %d", len(responses))
+	sort.Sort(linodes)
+
+	return []Linode(linodes), nil
+}
+
+// LinodeListSorted is like LinodeList, but returns the Linodes sorted by less rather than by DisplayGroup and Label
+func (c *Client) LinodeListSorted(less func(a, b Linode) bool) ([]Linode, error) {
+	return c.LinodeListSortedContext(context.Background(), less)
+}
+
+// LinodeListSortedContext is like LinodeListSorted, with ctx to cancel the API requests
+func (c *Client) LinodeListSortedContext(ctx context.Context, less func(a, b Linode) bool) ([]Linode, error) {
+	var linodes []Linode
+	if err := c.doActionContext(ctx, linodeListAction, nil, &linodes); err != nil {
+		return nil, err
 	}
+	sort.Slice(linodes, func(i, j int) bool {
+		return less(linodes[i], linodes[j])
+	})
+	return linodes, nil
+}
 
-	var linodes sortedLinodes
-	if responses[0].Action != linodeListAction {
-		return nil, fmt.Errorf("unexpected api action %s", responses[0].Action)
+// LinodeInfo returns the Linode with the given ID, or an error wrapping ErrLinodeNotFound if it is not found.
+func (c *Client) LinodeInfo(linodeID int) (*Linode, error) {
+	return c.LinodeInfoContext(context.Background(), linodeID)
+}
+
+// LinodeInfoContext is like LinodeInfo, with ctx to cancel the API requests
+func (c *Client) LinodeInfoContext(ctx context.Context, linodeID int) (*Linode, error) {
+	var linodes []Linode
+	if err := c.doActionContext(ctx, linodeListAction, map[string]string{"LinodeID": strconv.Itoa(linodeID)}, &linodes); err != nil {
+		return nil, err
+	}
+	for _, l := range linodes {
+		if l.ID == linodeID {
+			return &l, nil
+		}
 	}
-	if err = json.Unmarshal(responses[0].Data, &linodes); err != nil {
+	return nil, fmt.Errorf("linode %d: %w", linodeID, ErrLinodeNotFound)
+}
+
+// LinodeListByGroup returns slice of Linodes whose DisplayGroup exactly matches group (case sensitive).
+// All Linodes are fetched and filtered client side; the order of LinodeList is preserved.
+func (c *Client) LinodeListByGroup(group string) ([]Linode, error) {
+	return c.LinodeListByGroupContext(context.Background(), group)
+}
+
+// LinodeListByGroupContext is like LinodeListByGroup, with ctx to cancel the API requests
+func (c *Client) LinodeListByGroupContext(ctx context.Context, group string) ([]Linode, error) {
+	linodes, err := c.LinodeListContext(ctx)
+	if err != nil {
 		return nil, err
 	}
-	sort.Sort(linodes)
+	var filtered []Linode
+	for _, l := range linodes {
+		if l.DisplayGroup == group {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered, nil
+}
 
-	return []Linode(linodes), nil
+// RunningLinodes returns slice of Linodes which are running, in the order of LinodeList
+func (c *Client) RunningLinodes() ([]Linode, error) {
+	return c.RunningLinodesContext(context.Background())
+}
+
+// RunningLinodesContext is like RunningLinodes, with ctx to cancel the API requests
+func (c *Client) RunningLinodesContext(ctx context.Context) ([]Linode, error) {
+	linodes, err := c.LinodeListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var running []Linode
+	for _, l := range linodes {
+		if l.IsRunning() {
+			running = append(running, l)
+		}
+	}
+	return running, nil
 }
 
 // LinodeIPList returns mapping of LinodeID to slice of its LinodeIPs
 func (c *Client) LinodeIPList(linodeIDs []int) (map[int][]LinodeIP, error) {
-	req := c.NewRequest()
-	var err error
-	// batch all requests together
-	for _, id := range linodeIDs {
-		idVal := strconv.Itoa(id)
-		req.AddAction(linodeIPListAction, map[string]string{"LinodeID": idVal})
-	}
+	return c.LinodeIPListContext(context.Background(), linodeIDs)
+}
 
-	responses, err := req.GetJSON()
+// LinodeIPListContext is like LinodeIPList, with ctx to cancel the API requests
+func (c *Client) LinodeIPListContext(ctx context.Context, linodeIDs []int) (map[int][]LinodeIP, error) {
+	responses, err := c.doBatchActions(ctx, linodeIPListAction, "LinodeID", linodeIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	m := make(map[int][]LinodeIP, len(responses))
 	for _, r := range responses {
-		if r.Action != linodeIPListAction {
-			return nil, fmt.Errorf("unexpected api action %s", r.Action)
-		}
 		var ips sortedLinodeIPs
-		if err = json.Unmarshal(r.Data, &ips); err != nil {
+		if err = unmarshalData(r.Data, &ips); err != nil {
 			return nil, err
 		}
 		if len(ips) > 0 {
@@ -70,13 +162,396 @@ func (c *Client) LinodeIPList(linodeIDs []int) (map[int][]LinodeIP, error) {
 	return m, nil
 }
 
+// CreateLinode creates a Linode and returns its LinodeID. paymentTerm is in months, and defaults to 1 if 0.
+// Errors reported by the API, such as insufficient funds, are returned as APIErrors.
+func (c *Client) CreateLinode(datacenterID, planID int, paymentTerm int) (int, error) {
+	return c.CreateLinodeContext(context.Background(), datacenterID, planID, paymentTerm)
+}
+
+// CreateLinodeContext is like CreateLinode, with ctx to cancel the API requests
+func (c *Client) CreateLinodeContext(ctx context.Context, datacenterID, planID int, paymentTerm int) (int, error) {
+	if paymentTerm == 0 {
+		paymentTerm = 1
+	}
+	params := map[string]string{
+		"DatacenterID": strconv.Itoa(datacenterID),
+		"PlanID":       strconv.Itoa(planID),
+		"PaymentTerm":  strconv.Itoa(paymentTerm),
+	}
+	var linode linodeIDResponse
+	if err := c.doActionContext(ctx, linodeCreateAction, params, &linode); err != nil {
+		return 0, err
+	}
+	return linode.LinodeID, nil
+}
+
+// CloneLinode clones a Linode into a new Linode and returns the new LinodeID. paymentTerm is in months, and defaults to 1 if 0.
+// Errors reported by the API, such as an exhausted quota, are returned as APIErrors.
+func (c *Client) CloneLinode(linodeID, datacenterID, planID, paymentTerm int) (int, error) {
+	return c.CloneLinodeContext(context.Background(), linodeID, datacenterID, planID, paymentTerm)
+}
+
+// CloneLinodeContext is like CloneLinode, with ctx to cancel the API requests
+func (c *Client) CloneLinodeContext(ctx context.Context, linodeID, datacenterID, planID, paymentTerm int) (int, error) {
+	if paymentTerm == 0 {
+		paymentTerm = 1
+	}
+	params := map[string]string{
+		"LinodeID":     strconv.Itoa(linodeID),
+		"DatacenterID": strconv.Itoa(datacenterID),
+		"PlanID":       strconv.Itoa(planID),
+		"PaymentTerm":  strconv.Itoa(paymentTerm),
+	}
+	var linode linodeIDResponse
+	if err := c.doActionContext(ctx, linodeCloneAction, params, &linode); err != nil {
+		return 0, err
+	}
+	return linode.LinodeID, nil
+}
+
+// DeleteLinode deletes a Linode. If skipChecks is true, the Linode is deleted even if it still has disks or configs.
+func (c *Client) DeleteLinode(linodeID int, skipChecks bool) error {
+	return c.DeleteLinodeContext(context.Background(), linodeID, skipChecks)
+}
+
+// DeleteLinodeContext is like DeleteLinode, with ctx to cancel the API requests
+func (c *Client) DeleteLinodeContext(ctx context.Context, linodeID int, skipChecks bool) error {
+	params := map[string]string{"LinodeID": strconv.Itoa(linodeID)}
+	if skipChecks {
+		params["skipChecks"] = boolParam(skipChecks)
+	}
+	var linode linodeIDResponse
+	return c.doActionContext(ctx, linodeDeleteAction, params, &linode)
+}
+
+// ResizeLinode resizes a Linode to the given plan and returns the JobID of the resize job.
+// The resize migrates the Linode, use JobList to follow its progress.
+func (c *Client) ResizeLinode(linodeID, planID int) (int, error) {
+	return c.ResizeLinodeContext(context.Background(), linodeID, planID)
+}
+
+// ResizeLinodeContext is like ResizeLinode, with ctx to cancel the API requests
+func (c *Client) ResizeLinodeContext(ctx context.Context, linodeID, planID int) (int, error) {
+	params := map[string]string{
+		"LinodeID": strconv.Itoa(linodeID),
+		"PlanID":   strconv.Itoa(planID),
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeResizeAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// SetDisplayGroup sets the display group of all given Linodes.
+// The updates are sent as batch requests, so up to 24 Linodes are updated in a single round-trip.
+func (c *Client) SetDisplayGroup(linodeIDs []int, group string) error {
+	return c.SetDisplayGroupContext(context.Background(), linodeIDs, group)
+}
+
+// SetDisplayGroupContext is like SetDisplayGroup, with ctx to cancel the API requests
+func (c *Client) SetDisplayGroupContext(ctx context.Context, linodeIDs []int, group string) error {
+	req := c.NewRequest()
+	for _, id := range linodeIDs {
+		req.AddAction(linodeUpdateAction, map[string]string{
+			"LinodeID":         strconv.Itoa(id),
+			"lpm_displayGroup": group,
+		})
+	}
+
+	responses, err := req.GetJSONContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range responses {
+		if r.Action != linodeUpdateAction {
+			return fmt.Errorf("%w %s", ErrUnexpectedAction, r.Action)
+		}
+	}
+	return nil
+}
+
+// FirstPublicIP returns the first public IP address of the Linode, or ErrNoPublicIP if it has none
+func (c *Client) FirstPublicIP(linodeID int) (string, error) {
+	return c.FirstPublicIPContext(context.Background(), linodeID)
+}
+
+// FirstPublicIPContext is like FirstPublicIP, with ctx to cancel the API requests
+func (c *Client) FirstPublicIPContext(ctx context.Context, linodeID int) (string, error) {
+	ips, err := c.LinodeIPListContext(ctx, []int{linodeID})
+	if err != nil {
+		return "", err
+	}
+	public := PublicIPs(ips[linodeID])
+	if len(public) == 0 {
+		return "", ErrNoPublicIP
+	}
+	return public[0].IP, nil
+}
+
+// LinodeWithIPs is a Linode along with its IPs
+type LinodeWithIPs struct {
+	Linode
+	IPs []LinodeIP
+}
+
+// LinodesWithIPs returns slice of all Linodes, in the order of LinodeList, along with their IPs.
+// The IPs of all Linodes are requested in batches, after the Linodes themselves.
+func (c *Client) LinodesWithIPs() ([]LinodeWithIPs, error) {
+	return c.LinodesWithIPsContext(context.Background())
+}
+
+// LinodesWithIPsContext is like LinodesWithIPs, with ctx to cancel the API requests
+func (c *Client) LinodesWithIPsContext(ctx context.Context) ([]LinodeWithIPs, error) {
+	linodes, err := c.LinodeListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := c.LinodeIPListContext(ctx, linodeIDs(linodes))
+	if err != nil {
+		return nil, err
+	}
+
+	withIPs := make([]LinodeWithIPs, len(linodes))
+	for i, l := range linodes {
+		withIPs[i] = LinodeWithIPs{Linode: l, IPs: ips[l.ID]}
+	}
+	return withIPs, nil
+}
+
+// AllLinodeIPs returns mapping of LinodeID to slice of its LinodeIPs, for all Linodes of LinodeList.
+// The map is empty if there are no Linodes.
+func (c *Client) AllLinodeIPs() (map[int][]LinodeIP, error) {
+	return c.AllLinodeIPsContext(context.Background())
+}
+
+// AllLinodeIPsContext is like AllLinodeIPs, with ctx to cancel the API requests
+func (c *Client) AllLinodeIPsContext(ctx context.Context) (map[int][]LinodeIP, error) {
+	linodes, err := c.LinodeListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(linodes) == 0 {
+		return map[int][]LinodeIP{}, nil
+	}
+	return c.LinodeIPListContext(ctx, linodeIDs(linodes))
+}
+
+// linodeIDs returns the IDs of linodes
+func linodeIDs(linodes []Linode) []int {
+	ids := make([]int, len(linodes))
+	for i, l := range linodes {
+		ids[i] = l.ID
+	}
+	return ids
+}
+
+// Boot boots the Linode and returns the JobID of the boot job. If configID is 0, the last booted or default config is used.
+func (c *Client) Boot(linodeID int, configID int) (int, error) {
+	return c.BootContext(context.Background(), linodeID, configID)
+}
+
+// BootContext is like Boot, with ctx to cancel the API requests
+func (c *Client) BootContext(ctx context.Context, linodeID int, configID int) (int, error) {
+	params := map[string]string{"LinodeID": strconv.Itoa(linodeID)}
+	if configID != 0 {
+		params["ConfigID"] = strconv.Itoa(configID)
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeBootAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// Shutdown issues a shutdown of the Linode and returns the JobID of the shutdown job
+func (c *Client) Shutdown(linodeID int) (int, error) {
+	return c.ShutdownContext(context.Background(), linodeID)
+}
+
+// ShutdownContext is like Shutdown, with ctx to cancel the API requests
+func (c *Client) ShutdownContext(ctx context.Context, linodeID int) (int, error) {
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeShutdownAction, map[string]string{"LinodeID": strconv.Itoa(linodeID)}, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// Reboot reboots the Linode and returns the JobID of the reboot job. If configID is 0, the last booted or default config is used.
+func (c *Client) Reboot(linodeID int, configID int) (int, error) {
+	return c.RebootContext(context.Background(), linodeID, configID)
+}
+
+// RebootContext is like Reboot, with ctx to cancel the API requests
+func (c *Client) RebootContext(ctx context.Context, linodeID int, configID int) (int, error) {
+	params := map[string]string{"LinodeID": strconv.Itoa(linodeID)}
+	if configID != 0 {
+		params["ConfigID"] = strconv.Itoa(configID)
+	}
+	var job jobResponse
+	if err := c.doActionContext(ctx, linodeRebootAction, params, &job); err != nil {
+		return 0, err
+	}
+	return job.JobID, nil
+}
+
+// WebConsoleToken returns a token for the Linode's web console (LISH).
+// If console access is disabled, the error reported by the API is returned as APIErrors.
+func (c *Client) WebConsoleToken(linodeID int) (string, error) {
+	return c.WebConsoleTokenContext(context.Background(), linodeID)
+}
+
+// WebConsoleTokenContext is like WebConsoleToken, with ctx to cancel the API requests
+func (c *Client) WebConsoleTokenContext(ctx context.Context, linodeID int) (string, error) {
+	var data struct {
+		Token string `json:"TOKEN"`
+	}
+	if err := c.doActionContext(ctx, linodeWebConsoleTokenAction, map[string]string{"LinodeID": strconv.Itoa(linodeID)}, &data); err != nil {
+		return "", err
+	}
+	return data.Token, nil
+}
+
+// Call performs a single API action, for actions without a typed method. It verifies that the response is for action,
+// and unmarshals its 'DATA' into dest. If dest points to a slice, an empty object 'DATA' is treated as an empty array.
+func (c *Client) Call(action string, params map[string]string, dest interface{}) error {
+	return c.CallContext(context.Background(), action, params, dest)
+}
+
+// CallContext is like Call, with ctx to cancel the API requests
+func (c *Client) CallContext(ctx context.Context, action string, params map[string]string, dest interface{}) error {
+	return c.doActionContext(ctx, action, params, dest)
+}
+
+// ActionSpec is an API action with its params, see CallMany
+type ActionSpec struct {
+	Method string
+	Params map[string]string
+}
+
+// CallMany performs the given API actions in batches, respecting the client's batch limit, and returns their Responses
+// in the order of actions. Like GetJSON, the successful Responses are returned along with any error.
+func (c *Client) CallMany(actions []ActionSpec) ([]Response, error) {
+	return c.CallManyContext(context.Background(), actions)
+}
+
+// CallManyContext is like CallMany, with ctx to cancel the API requests
+func (c *Client) CallManyContext(ctx context.Context, actions []ActionSpec) ([]Response, error) {
+	req := c.NewRequest()
+	for _, a := range actions {
+		req.AddAction(a.Method, a.Params)
+	}
+	return req.GetJSONContext(ctx)
+}
+
+// doAction performs a single API action and unmarshals its 'DATA' into v
+func (c *Client) doAction(method string, params map[string]string, v interface{}) error {
+	return c.doActionContext(context.Background(), method, params, v)
+}
+
+// doActionContext is like doAction, but the HTTP request is bound to ctx
+func (c *Client) doActionContext(ctx context.Context, method string, params map[string]string, v interface{}) error {
+	responses, err := c.NewRequest().AddAction(method, params).GetJSONContext(ctx)
+	if err != nil {
+		return err
+	}
+	if len(responses) == 0 {
+		return ErrNoResponse
+	}
+	// search for the response of method, rather than relying on the order of responses
+	var match *Response
+	for i := range responses {
+		if responses[i].Action != method {
+			continue
+		}
+		if match != nil {
+			return fmt.Errorf("%w: %d", ErrUnexpectedResponseCount, len(responses))
+		}
+		match = &responses[i]
+	}
+	if match == nil {
+		return fmt.Errorf("%w %s", ErrUnexpectedAction, responses[0].Action)
+	}
+	return unmarshalData(match.Data, v)
+}
+
+// unmarshalData unmarshals the 'DATA' of a response into v. The API returns an empty object
+// rather than an empty array for some empty lists, so if v points to a slice, {} is treated as [].
+func unmarshalData(data json.RawMessage, v interface{}) error {
+	if string(bytes.TrimSpace(data)) == "{}" {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Slice {
+			data = json.RawMessage("[]")
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+// doBatchActions batches one API action per id, passing the id as idParam, and returns the responses
+func (c *Client) doBatchActions(ctx context.Context, method string, idParam string, ids []int) ([]Response, error) {
+	req := c.NewRequest()
+	for _, id := range ids {
+		req.AddAction(method, map[string]string{idParam: strconv.Itoa(id)})
+	}
+
+	responses, err := req.GetJSONContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range responses {
+		if r.Action != method {
+			return nil, fmt.Errorf("%w %s", ErrUnexpectedAction, r.Action)
+		}
+	}
+	return responses, nil
+}
+
+// boolParam formats b as an API boolean param
+func boolParam(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// jobResponse is the 'DATA' of actions which start a job
+type jobResponse struct {
+	JobID int `json:"JobID"`
+}
+
+// linodeIDResponse is the 'DATA' of actions which create a Linode
+type linodeIDResponse struct {
+	LinodeID int `json:"LinodeID"`
+}
+
 // Linode represent a Linode as returned by the API
 type Linode struct {
-	ID           int    `json:"LINODEID"`
-	Status       int    `json:"STATUS"`
-	Label        string `json:"LABEL"`
-	DisplayGroup string `json:"LPM_DISPLAYGROUP"`
-	RAM          int    `json:"TOTALRAM"`
+	ID              int        `json:"LINODEID"`
+	Status          int        `json:"STATUS"`
+	Label           string     `json:"LABEL"`
+	DisplayGroup    string     `json:"LPM_DISPLAYGROUP"`
+	RAM             int        `json:"TOTALRAM"`
+	DatacenterID    int        `json:"DATACENTERID"`
+	WatchdogEnabled int        `json:"WATCHDOG"`
+	TotalHD         int        `json:"TOTALHD"`
+	TotalXfer       int        `json:"TOTALXFER"`
+	CreateDT        LinodeTime `json:"CREATE_DT"`
+	BackupsEnabled  int        `json:"BACKUPSENABLED"`
+
+	AlertCPUEnabled   int `json:"ALERT_CPU_ENABLED"`
+	AlertCPUThreshold int `json:"ALERT_CPU_THRESHOLD"`
+	AlertBWInEnabled  int `json:"ALERT_BWIN_ENABLED"`
+	AlertBWOutEnabled int `json:"ALERT_BWOUT_ENABLED"`
+}
+
+// GroupByDisplayGroup returns mapping of DisplayGroup to its Linodes, preserving the order of linodes within each group.
+// Linodes without a display group are grouped under the empty string.
+func GroupByDisplayGroup(linodes []Linode) map[string][]Linode {
+	groups := make(map[string][]Linode)
+	for _, l := range linodes {
+		groups[l.DisplayGroup] = append(groups[l.DisplayGroup], l)
+	}
+	return groups
 }
 
 // IsRunning returns true if Status == 1
@@ -84,11 +559,39 @@ func (l Linode) IsRunning() bool {
 	return l.Status == 1
 }
 
+// HasAnyAlert returns true if the CPU, incoming or outgoing bandwidth alert is enabled
+func (l Linode) HasAnyAlert() bool {
+	return l.AlertCPUEnabled == 1 || l.AlertBWInEnabled == 1 || l.AlertBWOutEnabled == 1
+}
+
+// StatusString returns a human readable Status
+func (l Linode) StatusString() string {
+	switch l.Status {
+	case -2:
+		return "Boot Failed"
+	case -1:
+		return "Being Created"
+	case 0:
+		return "Brand New"
+	case 1:
+		return "Running"
+	case 2:
+		return "Powered Off"
+	case 3:
+		return "Shutting Down"
+	case 4:
+		return "Saved to Disk"
+	}
+	return fmt.Sprintf("Unknown (%d)", l.Status)
+}
+
 // LinodeIP respresents a Linode.IP as returned by the API
 type LinodeIP struct {
+	ID       int    `json:"IPADDRESSID"`
 	LinodeID int    `json:"LINODEID"`
 	Public   int    `json:"ISPUBLIC"`
 	IP       string `json:"IPADDRESS"`
+	RDNS     string `json:"RDNS_NAME"`
 }
 
 // IsPublic returns true if IP is public
@@ -96,6 +599,34 @@ func (i LinodeIP) IsPublic() bool {
 	return i.Public == 1
 }
 
+// IsIPv6 returns true if IP is an IPv6 address
+func (i LinodeIP) IsIPv6() bool {
+	ip := net.ParseIP(i.IP)
+	return ip != nil && ip.To4() == nil
+}
+
+// PublicIPs returns the public IPs of ips
+func PublicIPs(ips []LinodeIP) []LinodeIP {
+	var public []LinodeIP
+	for _, ip := range ips {
+		if ip.IsPublic() {
+			public = append(public, ip)
+		}
+	}
+	return public
+}
+
+// PrivateIPs returns the private IPs of ips
+func PrivateIPs(ips []LinodeIP) []LinodeIP {
+	var private []LinodeIP
+	for _, ip := range ips {
+		if !ip.IsPublic() {
+			private = append(private, ip)
+		}
+	}
+	return private
+}
+
 // Sort LinodeIPs by private IPs first
 type sortedLinodeIPs []LinodeIP
 
@@ -0,0 +1,653 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBoot(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1292},"ACTION":"linode.boot"}]`)
+	defer server.Close()
+
+	tests := []struct {
+		configID int
+		expected string
+	}{
+		{0, `[{"LinodeID":"8098","api_action":"linode.boot"}]`},
+		{30, `[{"ConfigID":"30","LinodeID":"8098","api_action":"linode.boot"}]`},
+	}
+	for _, test := range tests {
+		jobID, err := c.Boot(8098, test.configID)
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != test.expected {
+			t.Error("expected", test.expected, "given", *requestArray)
+		}
+		if jobID != 1292 {
+			t.Error("expected", 1292, "given", jobID)
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1293},"ACTION":"linode.shutdown"}]`)
+	defer server.Close()
+
+	jobID, err := c.Shutdown(8098)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := `[{"LinodeID":"8098","api_action":"linode.shutdown"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if jobID != 1293 {
+		t.Error("expected", 1293, "given", jobID)
+	}
+}
+
+func TestReboot(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1294},"ACTION":"linode.reboot"}]`)
+	defer server.Close()
+
+	tests := []struct {
+		configID int
+		expected string
+	}{
+		{0, `[{"LinodeID":"8098","api_action":"linode.reboot"}]`},
+		{30, `[{"ConfigID":"30","LinodeID":"8098","api_action":"linode.reboot"}]`},
+	}
+	for _, test := range tests {
+		jobID, err := c.Reboot(8098, test.configID)
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != test.expected {
+			t.Error("expected", test.expected, "given", *requestArray)
+		}
+		if jobID != 1294 {
+			t.Error("expected", 1294, "given", jobID)
+		}
+	}
+}
+
+func TestCreateLinode(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"LinodeID":8098},"ACTION":"linode.create"}]`)
+	defer server.Close()
+
+	tests := []struct {
+		paymentTerm int
+		expected    string
+	}{
+		{0, `[{"DatacenterID":"2","PaymentTerm":"1","PlanID":"1","api_action":"linode.create"}]`},
+		{12, `[{"DatacenterID":"2","PaymentTerm":"12","PlanID":"1","api_action":"linode.create"}]`},
+	}
+	for _, test := range tests {
+		linodeID, err := c.CreateLinode(2, 1, test.paymentTerm)
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != test.expected {
+			t.Error("expected", test.expected, "given", *requestArray)
+		}
+		if linodeID != 8098 {
+			t.Error("expected", 8098, "given", linodeID)
+		}
+	}
+}
+
+func TestCloneLinode(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"LinodeID":8099},"ACTION":"linode.clone"}]`)
+	defer server.Close()
+
+	tests := []struct {
+		paymentTerm int
+		expected    string
+	}{
+		{0, `[{"DatacenterID":"2","LinodeID":"8098","PaymentTerm":"1","PlanID":"1","api_action":"linode.clone"}]`},
+		{24, `[{"DatacenterID":"2","LinodeID":"8098","PaymentTerm":"24","PlanID":"1","api_action":"linode.clone"}]`},
+	}
+	for _, test := range tests {
+		linodeID, err := c.CloneLinode(8098, 2, 1, test.paymentTerm)
+		if err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != test.expected {
+			t.Error("expected", test.expected, "given", *requestArray)
+		}
+		if linodeID != 8099 {
+			t.Error("expected", 8099, "given", linodeID)
+		}
+	}
+}
+
+func TestResizeLinode(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"JobID":1295},"ACTION":"linode.resize"}]`)
+	defer server.Close()
+
+	jobID, err := c.ResizeLinode(8098, 3)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := `[{"LinodeID":"8098","PlanID":"3","api_action":"linode.resize"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if jobID != 1295 {
+		t.Error("expected", 1295, "given", jobID)
+	}
+}
+
+func TestDeleteLinode(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"LinodeID":8098},"ACTION":"linode.delete"}]`)
+	defer server.Close()
+
+	tests := []struct {
+		skipChecks bool
+		expected   string
+	}{
+		{false, `[{"LinodeID":"8098","api_action":"linode.delete"}]`},
+		{true, `[{"LinodeID":"8098","api_action":"linode.delete","skipChecks":"1"}]`},
+	}
+	for _, test := range tests {
+		if err := c.DeleteLinode(8098, test.skipChecks); err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if *requestArray != test.expected {
+			t.Error("expected", test.expected, "given", *requestArray)
+		}
+	}
+}
+
+func TestWebConsoleToken(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"TOKEN":"a1b2c3"},"ACTION":"linode.webconsoletoken"}]`)
+	defer server.Close()
+
+	token, err := c.WebConsoleToken(8098)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := `[{"LinodeID":"8098","api_action":"linode.webconsoletoken"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if token != "a1b2c3" {
+		t.Error("expected", "a1b2c3", "given", token)
+	}
+}
+
+func TestLinodeStatusString(t *testing.T) {
+	cases := []struct {
+		status   int
+		expected string
+	}{
+		{-2, "Boot Failed"},
+		{1, "Running"},
+		{4, "Saved to Disk"},
+		{7, "Unknown (7)"},
+	}
+	for _, testCase := range cases {
+		given := Linode{Status: testCase.status}.StatusString()
+		if given != testCase.expected {
+			t.Error("expected", testCase.expected, "given", given)
+		}
+	}
+}
+
+const testLinodeListResponse = `[{"ERRORARRAY":[],"ACTION":"linode.list","DATA":[{"TOTALXFER":2000,"BACKUPSENABLED":1,"WATCHDOG":1,"LPM_DISPLAYGROUP":"","ALERT_BWQUOTA_ENABLED":1,"STATUS":2,"TOTALRAM":1024,"ALERT_DISKIO_THRESHOLD":1000,"BACKUPWINDOW":1,"ALERT_BWOUT_ENABLED":1,"ALERT_BWOUT_THRESHOLD":5,"LABEL":"api-node3","ALERT_CPU_ENABLED":1,"ALERT_BWQUOTA_THRESHOLD":81,"ALERT_BWIN_THRESHOLD":5,"BACKUPWEEKLYDAY":0,"DATACENTERID":5,"ALERT_CPU_THRESHOLD":10,"TOTALHD":40960,"ALERT_DISKIO_ENABLED":1,"ALERT_BWIN_ENABLED":1,"LINODEID":8098,"CREATE_DT":"2015-09-22 11:33:06.0","PLANID":1,"DISTRIBUTIONVENDOR":"Debian","ISXEN":0,"ISKVM":1}]}]`
+
+func TestLinodeList(t *testing.T) {
+	c, server := newTestServerClient(testLinodeListResponse)
+	defer server.Close()
+
+	linodes, err := c.LinodeList()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if len(linodes) != 1 {
+		t.Error("expected", 1, "given", len(linodes))
+		return
+	}
+	expected := Linode{
+		ID:              8098,
+		Status:          2,
+		Label:           "api-node3",
+		RAM:             1024,
+		DatacenterID:    5,
+		WatchdogEnabled: 1,
+		TotalHD:         40960,
+		TotalXfer:       2000,
+		CreateDT:        LinodeTime{time.Date(2015, 9, 22, 11, 33, 6, 0, time.UTC)},
+		BackupsEnabled:  1,
+
+		AlertCPUEnabled:   1,
+		AlertCPUThreshold: 10,
+		AlertBWInEnabled:  1,
+		AlertBWOutEnabled: 1,
+	}
+	if linodes[0] != expected {
+		t.Error("expected", expected, "given", linodes[0])
+	}
+}
+
+func TestLinodeInfo(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"LINODEID":8098,"LABEL":"api-node3"}],"ACTION":"linode.list"}]`)
+	defer server.Close()
+
+	linode, err := c.LinodeInfo(8098)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := `[{"LinodeID":"8098","api_action":"linode.list"}]`
+	if *requestArray != expected {
+		t.Error("expected", expected, "given", *requestArray)
+	}
+	if linode.ID != 8098 || linode.Label != "api-node3" {
+		t.Error("unexpected linode", linode)
+	}
+
+	if _, err := c.LinodeInfo(8099); !errors.Is(err, ErrLinodeNotFound) {
+		t.Error("expected", ErrLinodeNotFound, "given", err)
+	}
+}
+
+func TestLinodeListSorted(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"ACTION":"linode.list","DATA":[{"LINODEID":3,"LABEL":"a","TOTALRAM":2048},{"LINODEID":1,"LABEL":"b","TOTALRAM":4096},{"LINODEID":2,"LABEL":"c","TOTALRAM":1024}]}]`)
+	defer server.Close()
+
+	linodes, err := c.LinodeListSorted(func(a, b Linode) bool { return a.RAM < b.RAM })
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := []int{2, 3, 1}
+	if len(linodes) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(linodes))
+	}
+	for i, id := range expected {
+		if linodes[i].ID != id {
+			t.Error("expected", id, "given", linodes[i].ID)
+		}
+	}
+}
+
+func TestGroupByDisplayGroup(t *testing.T) {
+	linodes := []Linode{
+		{ID: 1, Label: "a", DisplayGroup: ""},
+		{ID: 2, Label: "b", DisplayGroup: "db"},
+		{ID: 3, Label: "c", DisplayGroup: "web"},
+		{ID: 4, Label: "d", DisplayGroup: "db"},
+		{ID: 5, Label: "e", DisplayGroup: "web"},
+	}
+	groups := GroupByDisplayGroup(linodes)
+	expected := map[string][]int{
+		"":    {1},
+		"db":  {2, 4},
+		"web": {3, 5},
+	}
+	if len(groups) != len(expected) {
+		t.Error("expected", len(expected), "given", len(groups))
+	}
+	for group, ids := range expected {
+		if len(groups[group]) != len(ids) {
+			t.Error("expected", ids, "given", groups[group])
+			continue
+		}
+		for i, id := range ids {
+			if groups[group][i].ID != id {
+				t.Error("expected", id, "given", groups[group][i].ID)
+			}
+		}
+	}
+}
+
+func TestLinodeHasAnyAlert(t *testing.T) {
+	cases := []struct {
+		data     string
+		expected bool
+	}{
+		{`[{"ALERT_CPU_ENABLED":1,"ALERT_BWIN_ENABLED":1}]`, true},
+		{`[{"ALERT_BWOUT_ENABLED":1}]`, true},
+		{`[{"ALERT_CPU_ENABLED":0,"ALERT_CPU_THRESHOLD":90}]`, false},
+	}
+	for _, testCase := range cases {
+		var linodes []Linode
+		if err := json.Unmarshal([]byte(testCase.data), &linodes); err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if given := linodes[0].HasAnyAlert(); given != testCase.expected {
+			t.Error("expected", testCase.expected, "given", given)
+		}
+	}
+}
+
+func TestLinodeListByGroup(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"ACTION":"linode.list","DATA":[{"LINODEID":1,"LPM_DISPLAYGROUP":"web","LABEL":"c"},{"LINODEID":2,"LPM_DISPLAYGROUP":"Web","LABEL":"b"},{"LINODEID":3,"LPM_DISPLAYGROUP":"web","LABEL":"a"},{"LINODEID":4,"LPM_DISPLAYGROUP":"web servers","LABEL":"d"},{"LINODEID":5,"LPM_DISPLAYGROUP":"","LABEL":"e"}]}]`)
+	defer server.Close()
+
+	linodes, err := c.LinodeListByGroup("web")
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expected := []int{3, 1}
+	if len(linodes) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(linodes))
+	}
+	for i, id := range expected {
+		if linodes[i].ID != id {
+			t.Error("expected", id, "given", linodes[i].ID)
+		}
+	}
+
+	if linodes, err = c.LinodeListByGroup("WEB"); err != nil || len(linodes) != 0 {
+		t.Error("expected no linodes for differently cased group, given", linodes, err)
+	}
+}
+
+func TestRunningLinodes(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"ACTION":"linode.list","DATA":[{"LINODEID":1,"STATUS":1,"LABEL":"c"},{"LINODEID":2,"STATUS":2,"LABEL":"b"},{"LINODEID":3,"STATUS":1,"LABEL":"a"}]}]`)
+	defer server.Close()
+
+	linodes, err := c.RunningLinodes()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := []int{3, 1}
+	if len(linodes) != len(expected) {
+		t.Error("expected", len(expected), "given", len(linodes))
+		return
+	}
+	for i, id := range expected {
+		if linodes[i].ID != id {
+			t.Error("expected", id, "given", linodes[i].ID)
+		}
+	}
+}
+
+func TestLinodeListEmptyObject(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.list"}]`)
+	defer server.Close()
+
+	linodes, err := c.LinodeList()
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(linodes) != 0 {
+		t.Error("expected", 0, "given", len(linodes))
+	}
+}
+
+func TestPublicPrivateIPs(t *testing.T) {
+	ips := []LinodeIP{
+		{IP: "192.168.1.1", Public: 0},
+		{IP: "1.2.3.4", Public: 1},
+		{IP: "192.168.1.2", Public: 0},
+		{IP: "5.6.7.8", Public: 1},
+	}
+	public := PublicIPs(ips)
+	if len(public) != 2 || public[0].IP != "1.2.3.4" || public[1].IP != "5.6.7.8" {
+		t.Error("unexpected public IPs", public)
+	}
+	private := PrivateIPs(ips)
+	if len(private) != 2 || private[0].IP != "192.168.1.1" || private[1].IP != "192.168.1.2" {
+		t.Error("unexpected private IPs", private)
+	}
+}
+
+func TestLinodeIPIsIPv6(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"IPADDRESSID":1,"LINODEID":8098,"ISPUBLIC":1,"IPADDRESS":"1.2.3.4"},{"IPADDRESSID":2,"LINODEID":8098,"ISPUBLIC":1,"IPADDRESS":"2600:3c01::f03c:91ff:fe93:1"},{"IPADDRESSID":3,"LINODEID":8098,"ISPUBLIC":0,"IPADDRESS":"192.168.1.1"}],"ACTION":"linode.ip.list"}]`)
+	defer server.Close()
+
+	ipMap, err := c.LinodeIPList([]int{8098})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ips := ipMap[8098]
+	if len(ips) != 3 {
+		t.Fatal("expected", 3, "given", len(ips))
+	}
+	expected := map[string]bool{"1.2.3.4": false, "2600:3c01::f03c:91ff:fe93:1": true, "192.168.1.1": false}
+	for _, ip := range ips {
+		if ip.IsIPv6() != expected[ip.IP] {
+			t.Error("expected", expected[ip.IP], "given", ip.IsIPv6(), "for", ip.IP)
+		}
+	}
+}
+
+func TestSetDisplayGroup(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"LinodeID":8098},"ACTION":"linode.update"},{"ERRORARRAY":[],"DATA":{"LinodeID":8099},"ACTION":"linode.update"}]`)
+	defer server.Close()
+
+	if err := c.SetDisplayGroup([]int{8098, 8099}, "web"); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"LinodeID":"8098","api_action":"linode.update","lpm_displayGroup":"web"},{"LinodeID":"8099","api_action":"linode.update","lpm_displayGroup":"web"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+}
+
+func TestSetDisplayGroupError(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{"LinodeID":8098},"ACTION":"linode.update"},{"ERRORARRAY":[{"ERRORCODE":5,"ERRORMESSAGE":"Object not found"}],"DATA":{},"ACTION":"linode.update"}]`)
+	defer server.Close()
+
+	err := c.SetDisplayGroup([]int{8098, 1}, "web")
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 5 {
+		t.Error("expected API error code", 5, "given", err)
+	}
+}
+
+func TestLinodesWithIPs(t *testing.T) {
+	var ipRequestArray string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestArray := r.URL.Query().Get("api_requestArray")
+		if strings.Contains(requestArray, "linode.ip.list") {
+			ipRequestArray = requestArray
+			writeJSON(w, `[{"ERRORARRAY":[],"DATA":[{"IPADDRESSID":3,"LINODEID":3,"ISPUBLIC":1,"IPADDRESS":"5.6.7.8"}],"ACTION":"linode.ip.list"},{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.ip.list"},{"ERRORARRAY":[],"DATA":[{"IPADDRESSID":2,"LINODEID":2,"ISPUBLIC":0,"IPADDRESS":"192.168.1.2"},{"IPADDRESSID":1,"LINODEID":2,"ISPUBLIC":1,"IPADDRESS":"1.2.3.4"}],"ACTION":"linode.ip.list"}]`)
+			return
+		}
+		writeJSON(w, `[{"ERRORARRAY":[],"ACTION":"linode.list","DATA":[{"LINODEID":1,"LABEL":"a","LPM_DISPLAYGROUP":"db"},{"LINODEID":2,"LABEL":"a","LPM_DISPLAYGROUP":"web"},{"LINODEID":3,"LABEL":"b","LPM_DISPLAYGROUP":""}]}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	withIPs, err := c.LinodesWithIPs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"LinodeID":"3","api_action":"linode.ip.list"},{"LinodeID":"1","api_action":"linode.ip.list"},{"LinodeID":"2","api_action":"linode.ip.list"}]`
+	if ipRequestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", ipRequestArray)
+	}
+	// in the order of LinodeList, by DisplayGroup then Label
+	expected := []struct {
+		id  int
+		ips []string
+	}{
+		{3, []string{"5.6.7.8"}},
+		{1, nil},
+		{2, []string{"192.168.1.2", "1.2.3.4"}}, // private IPs first
+	}
+	if len(withIPs) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(withIPs))
+	}
+	for i, e := range expected {
+		if withIPs[i].ID != e.id {
+			t.Error("expected", e.id, "given", withIPs[i].ID)
+		}
+		if len(withIPs[i].IPs) != len(e.ips) {
+			t.Error("expected", e.ips, "given", withIPs[i].IPs)
+			continue
+		}
+		for j, ip := range e.ips {
+			if withIPs[i].IPs[j].IP != ip {
+				t.Error("expected", ip, "given", withIPs[i].IPs[j].IP)
+			}
+		}
+	}
+}
+
+func TestAllLinodeIPs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Query().Get("api_requestArray"), "linode.ip.list") {
+			writeJSON(w, `[{"ERRORARRAY":[],"DATA":[{"IPADDRESSID":1,"LINODEID":8098,"ISPUBLIC":1,"IPADDRESS":"1.2.3.4"}],"ACTION":"linode.ip.list"}]`)
+			return
+		}
+		writeJSON(w, testLinodeListResponse)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL))
+	ips, err := c.AllLinodeIPs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if len(ips) != 1 || len(ips[8098]) != 1 || ips[8098][0].IP != "1.2.3.4" {
+		t.Error("unexpected IPs", ips)
+	}
+}
+
+func TestAllLinodeIPsEmpty(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.list"}]`)
+	defer server.Close()
+
+	ips, err := c.AllLinodeIPs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if ips == nil || len(ips) != 0 {
+		t.Error("expected empty map, given", ips)
+	}
+}
+
+func TestFirstPublicIP(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"IPADDRESSID":1,"LINODEID":8098,"ISPUBLIC":0,"IPADDRESS":"192.168.1.1"},{"IPADDRESSID":2,"LINODEID":8098,"ISPUBLIC":1,"IPADDRESS":"1.2.3.4"}],"ACTION":"linode.ip.list"}]`)
+	defer server.Close()
+
+	ip, err := c.FirstPublicIP(8098)
+	if err != nil {
+		t.Error("unexpected error", err)
+	}
+	if ip != "1.2.3.4" {
+		t.Error("expected", "1.2.3.4", "given", ip)
+	}
+
+	c, server = newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"IPADDRESSID":1,"LINODEID":8098,"ISPUBLIC":0,"IPADDRESS":"192.168.1.1"}],"ACTION":"linode.ip.list"}]`)
+	defer server.Close()
+	if _, err = c.FirstPublicIP(8098); err != ErrNoPublicIP {
+		t.Error("expected", ErrNoPublicIP, "given", err)
+	}
+}
+
+func TestDoActionErrors(t *testing.T) {
+	cases := []struct {
+		response string
+		expected error
+	}{
+		{`[]`, ErrNoResponse},
+		{`[{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.list"},{"ERRORARRAY":[],"DATA":{},"ACTION":"linode.list"}]`, ErrUnexpectedResponseCount},
+		{`[{"ERRORARRAY":[],"DATA":[],"ACTION":"linode.ip.list"}]`, ErrUnexpectedAction},
+	}
+	for _, testCase := range cases {
+		c, server := newTestServerClient(testCase.response)
+		_, err := c.LinodeList()
+		server.Close()
+		if !errors.Is(err, testCase.expected) {
+			t.Error("expected", testCase.expected, "given", err)
+		}
+	}
+}
+
+func TestDoActionSearchesResponses(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"},` + testLinodeListResponse[1:])
+	defer server.Close()
+
+	linodes, err := c.LinodeList()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if len(linodes) != 1 || linodes[0].ID != 8098 {
+		t.Error("unexpected linodes", linodes)
+	}
+}
+
+func TestCall(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":{"ProfileID":17,"Label":"default"},"ACTION":"test.profile"}]`)
+	defer server.Close()
+
+	var profile struct {
+		ID    int    `json:"ProfileID"`
+		Label string `json:"Label"`
+	}
+	if err := c.Call("test.profile", map[string]string{"ProfileID": "17"}, &profile); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	expectedRequest := `[{"ProfileID":"17","api_action":"test.profile"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	if profile.ID != 17 || profile.Label != "default" {
+		t.Error("unexpected profile", profile)
+	}
+
+	if err := c.Call("test.other", nil, &profile); !errors.Is(err, ErrUnexpectedAction) {
+		t.Error("expected", ErrUnexpectedAction, "given", err)
+	}
+}
+
+func TestCallMany(t *testing.T) {
+	var requestArrays []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestArrays = append(requestArrays, r.URL.Query().Get("api_requestArray"))
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":{},"ACTION":"test.echo"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(testAPIKey, WithBaseURL(server.URL), WithBatchLimit(1))
+	responses, err := c.CallMany([]ActionSpec{
+		{Method: "test.echo", Params: map[string]string{"foo": "1"}},
+		{Method: "test.echo", Params: map[string]string{"foo": "2"}},
+	})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if len(responses) != 2 {
+		t.Error("expected", 2, "given", len(responses))
+	}
+	expected := []string{`[{"api_action":"test.echo","foo":"1"}]`, `[{"api_action":"test.echo","foo":"2"}]`}
+	if len(requestArrays) != len(expected) {
+		t.Fatal("expected", len(expected), "given", len(requestArrays))
+	}
+	for i, requestArray := range expected {
+		if requestArrays[i] != requestArray {
+			t.Error("expected", requestArray, "given", requestArrays[i])
+		}
+	}
+}
+
+func TestLinodeListContextCanceled(t *testing.T) {
+	c, server := newTestServerClient(testLinodeListResponse)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.LinodeListContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Error("expected", context.Canceled, "given", err)
+	}
+}
@@ -0,0 +1,171 @@
+package linode
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+const (
+	nodeBalancerListAction       = "nodebalancer.list"
+	nodeBalancerConfigListAction = "nodebalancer.config.list"
+	nodeBalancerNodeListAction   = "nodebalancer.node.list"
+)
+
+// NodeBalancerList returns slice of NodeBalancers, sorted by Label
+func (c *Client) NodeBalancerList() ([]NodeBalancer, error) {
+	return c.NodeBalancerListContext(context.Background())
+}
+
+// NodeBalancerListContext is like NodeBalancerList, with ctx to cancel the API requests
+func (c *Client) NodeBalancerListContext(ctx context.Context) ([]NodeBalancer, error) {
+	var nodeBalancers sortedNodeBalancers
+	if err := c.doActionContext(ctx, nodeBalancerListAction, nil, &nodeBalancers); err != nil {
+		return nil, err
+	}
+	sort.Sort(nodeBalancers)
+
+	return []NodeBalancer(nodeBalancers), nil
+}
+
+// NodeBalancerConfigList returns mapping of NodeBalancerID to slice of its NodeBalancerConfigs, sorted by ConfigID
+func (c *Client) NodeBalancerConfigList(nbIDs []int) (map[int][]NodeBalancerConfig, error) {
+	return c.NodeBalancerConfigListContext(context.Background(), nbIDs)
+}
+
+// NodeBalancerConfigListContext is like NodeBalancerConfigList, with ctx to cancel the API requests
+func (c *Client) NodeBalancerConfigListContext(ctx context.Context, nbIDs []int) (map[int][]NodeBalancerConfig, error) {
+	responses, err := c.doBatchActions(ctx, nodeBalancerConfigListAction, "NodeBalancerID", nbIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int][]NodeBalancerConfig, len(responses))
+	for _, r := range responses {
+		var configs sortedNodeBalancerConfigs
+		if err = unmarshalData(r.Data, &configs); err != nil {
+			return nil, err
+		}
+		if len(configs) > 0 {
+			sort.Sort(configs)
+			m[configs[0].NodeBalancerID] = []NodeBalancerConfig(configs)
+		}
+	}
+
+	return m, nil
+}
+
+// NodeBalancerNodeList returns mapping of ConfigID to slice of its NodeBalancerNodes, sorted by NodeID
+func (c *Client) NodeBalancerNodeList(configIDs []int) (map[int][]NodeBalancerNode, error) {
+	return c.NodeBalancerNodeListContext(context.Background(), configIDs)
+}
+
+// NodeBalancerNodeListContext is like NodeBalancerNodeList, with ctx to cancel the API requests
+func (c *Client) NodeBalancerNodeListContext(ctx context.Context, configIDs []int) (map[int][]NodeBalancerNode, error) {
+	responses, err := c.doBatchActions(ctx, nodeBalancerNodeListAction, "ConfigID", configIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int][]NodeBalancerNode, len(responses))
+	for _, r := range responses {
+		var nodes sortedNodeBalancerNodes
+		if err = unmarshalData(r.Data, &nodes); err != nil {
+			return nil, err
+		}
+		if len(nodes) > 0 {
+			sort.Sort(nodes)
+			m[nodes[0].ConfigID] = []NodeBalancerNode(nodes)
+		}
+	}
+
+	return m, nil
+}
+
+// NodeBalancer represents a NodeBalancer as returned by the API
+type NodeBalancer struct {
+	ID                 int    `json:"NODEBALANCERID"`
+	Label              string `json:"LABEL"`
+	Hostname           string `json:"HOSTNAME"`
+	Address4           string `json:"ADDRESS4"`
+	Address6           string `json:"ADDRESS6"`
+	Status             int    `json:"STATUS"`
+	ClientConnThrottle int    `json:"CLIENTCONNTHROTTLE"`
+}
+
+// Sort NodeBalancers by Label
+type sortedNodeBalancers []NodeBalancer
+
+func (sorted sortedNodeBalancers) Len() int {
+	return len(sorted)
+}
+func (sorted sortedNodeBalancers) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedNodeBalancers) Less(i, j int) bool {
+	return sorted[i].Label < sorted[j].Label
+}
+
+// NodeBalancerConfig represents a NodeBalancer config as returned by the API
+type NodeBalancerConfig struct {
+	ID             int    `json:"CONFIGID"`
+	NodeBalancerID int    `json:"NODEBALANCERID"`
+	Port           int    `json:"PORT"`
+	Protocol       string `json:"PROTOCOL"`
+	Algorithm      string `json:"ALGORITHM"`
+	Stickiness     string `json:"STICKINESS"`
+	Check          string `json:"CHECK"`
+	CheckInterval  int    `json:"CHECK_INTERVAL"`
+}
+
+// Sort NodeBalancerConfigs by ID
+type sortedNodeBalancerConfigs []NodeBalancerConfig
+
+func (sorted sortedNodeBalancerConfigs) Len() int {
+	return len(sorted)
+}
+func (sorted sortedNodeBalancerConfigs) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedNodeBalancerConfigs) Less(i, j int) bool {
+	return sorted[i].ID < sorted[j].ID
+}
+
+// NodeBalancerNode represents a backend node of a NodeBalancer config as returned by the API
+type NodeBalancerNode struct {
+	ID             int    `json:"NODEID"`
+	ConfigID       int    `json:"CONFIGID"`
+	NodeBalancerID int    `json:"NODEBALANCERID"`
+	Label          string `json:"LABEL"`
+	Address        string `json:"ADDRESS"`
+	Weight         int    `json:"WEIGHT"`
+	Mode           string `json:"MODE"`
+	Status         string `json:"STATUS"`
+}
+
+// StatusString returns a human readable Status: "Up", "Down" or "Unknown"
+func (n NodeBalancerNode) StatusString() string {
+	switch strings.ToUpper(n.Status) {
+	case "UP":
+		return "Up"
+	case "DOWN":
+		return "Down"
+	}
+	return "Unknown"
+}
+
+// Sort NodeBalancerNodes by ID
+type sortedNodeBalancerNodes []NodeBalancerNode
+
+func (sorted sortedNodeBalancerNodes) Len() int {
+	return len(sorted)
+}
+func (sorted sortedNodeBalancerNodes) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedNodeBalancerNodes) Less(i, j int) bool {
+	return sorted[i].ID < sorted[j].ID
+}
@@ -0,0 +1,113 @@
+package linode
+
+import "testing"
+
+func TestNodeBalancerList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"NODEBALANCERID":2,"LABEL":"web","HOSTNAME":"nb-2.newark.nodebalancer.linode.com","ADDRESS4":"1.2.3.5","ADDRESS6":"2600:3c03::1","STATUS":1,"CLIENTCONNTHROTTLE":0},{"NODEBALANCERID":1,"LABEL":"api","HOSTNAME":"nb-1.newark.nodebalancer.linode.com","ADDRESS4":"1.2.3.4","ADDRESS6":"2600:3c03::2","STATUS":1,"CLIENTCONNTHROTTLE":5}],"ACTION":"nodebalancer.list"}]`)
+	defer server.Close()
+
+	nodeBalancers, err := c.NodeBalancerList()
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expected := []NodeBalancer{
+		{ID: 1, Label: "api", Hostname: "nb-1.newark.nodebalancer.linode.com", Address4: "1.2.3.4", Address6: "2600:3c03::2", Status: 1, ClientConnThrottle: 5},
+		{ID: 2, Label: "web", Hostname: "nb-2.newark.nodebalancer.linode.com", Address4: "1.2.3.5", Address6: "2600:3c03::1", Status: 1},
+	}
+	if len(nodeBalancers) != len(expected) {
+		t.Error("expected", len(expected), "given", len(nodeBalancers))
+		return
+	}
+	for i, nb := range expected {
+		if nodeBalancers[i] != nb {
+			t.Error("expected", nb, "given", nodeBalancers[i])
+		}
+	}
+}
+
+func TestNodeBalancerConfigList(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"CONFIGID":14,"NODEBALANCERID":1,"PORT":443,"PROTOCOL":"https","ALGORITHM":"roundrobin","STICKINESS":"table","CHECK":"connection","CHECK_INTERVAL":5},{"CONFIGID":13,"NODEBALANCERID":1,"PORT":80,"PROTOCOL":"http","ALGORITHM":"roundrobin","STICKINESS":"none","CHECK":"http","CHECK_INTERVAL":5}],"ACTION":"nodebalancer.config.list"},{"ERRORARRAY":[],"DATA":[{"CONFIGID":20,"NODEBALANCERID":2,"PORT":80,"PROTOCOL":"tcp","ALGORITHM":"leastconn","STICKINESS":"none","CHECK":"connection","CHECK_INTERVAL":10}],"ACTION":"nodebalancer.config.list"},{"ERRORARRAY":[],"DATA":{},"ACTION":"nodebalancer.config.list"}]`)
+	defer server.Close()
+
+	configs, err := c.NodeBalancerConfigList([]int{1, 2, 3})
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"NodeBalancerID":"1","api_action":"nodebalancer.config.list"},{"NodeBalancerID":"2","api_action":"nodebalancer.config.list"},{"NodeBalancerID":"3","api_action":"nodebalancer.config.list"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := map[int][]int{1: {13, 14}, 2: {20}}
+	if len(configs) != len(expected) {
+		t.Error("expected", len(expected), "given", len(configs))
+	}
+	for nbID, ids := range expected {
+		if len(configs[nbID]) != len(ids) {
+			t.Error("expected", ids, "given", configs[nbID])
+			continue
+		}
+		for i, id := range ids {
+			if configs[nbID][i].ID != id || configs[nbID][i].NodeBalancerID != nbID {
+				t.Error("expected config", id, "of", nbID, "given", configs[nbID][i])
+			}
+		}
+	}
+}
+
+func TestNodeBalancerNodeList(t *testing.T) {
+	c, server, requestArray := newRecordingTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"NODEID":102,"CONFIGID":13,"NODEBALANCERID":1,"LABEL":"web2","ADDRESS":"192.168.1.2:80","WEIGHT":100,"MODE":"accept","STATUS":"UP"},{"NODEID":101,"CONFIGID":13,"NODEBALANCERID":1,"LABEL":"web1","ADDRESS":"192.168.1.1:80","WEIGHT":100,"MODE":"accept","STATUS":"DOWN"}],"ACTION":"nodebalancer.node.list"},{"ERRORARRAY":[],"DATA":[{"NODEID":201,"CONFIGID":20,"NODEBALANCERID":2,"LABEL":"db1","ADDRESS":"192.168.2.1:5432","WEIGHT":50,"MODE":"drain","STATUS":"Unknown"}],"ACTION":"nodebalancer.node.list"}]`)
+	defer server.Close()
+
+	nodes, err := c.NodeBalancerNodeList([]int{13, 20})
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	expectedRequest := `[{"ConfigID":"13","api_action":"nodebalancer.node.list"},{"ConfigID":"20","api_action":"nodebalancer.node.list"}]`
+	if *requestArray != expectedRequest {
+		t.Error("expected", expectedRequest, "given", *requestArray)
+	}
+	expected := map[int][]NodeBalancerNode{
+		13: {
+			{ID: 101, ConfigID: 13, NodeBalancerID: 1, Label: "web1", Address: "192.168.1.1:80", Weight: 100, Mode: "accept", Status: "DOWN"},
+			{ID: 102, ConfigID: 13, NodeBalancerID: 1, Label: "web2", Address: "192.168.1.2:80", Weight: 100, Mode: "accept", Status: "UP"},
+		},
+		20: {
+			{ID: 201, ConfigID: 20, NodeBalancerID: 2, Label: "db1", Address: "192.168.2.1:5432", Weight: 50, Mode: "drain", Status: "Unknown"},
+		},
+	}
+	if len(nodes) != len(expected) {
+		t.Error("expected", len(expected), "given", len(nodes))
+	}
+	for configID, configNodes := range expected {
+		if len(nodes[configID]) != len(configNodes) {
+			t.Error("expected", configNodes, "given", nodes[configID])
+			continue
+		}
+		for i, n := range configNodes {
+			if nodes[configID][i] != n {
+				t.Error("expected", n, "given", nodes[configID][i])
+			}
+		}
+	}
+}
+
+func TestNodeBalancerNodeStatusString(t *testing.T) {
+	cases := []struct {
+		status   string
+		expected string
+	}{
+		{"UP", "Up"},
+		{"up", "Up"},
+		{"DOWN", "Down"},
+		{"Unknown", "Unknown"},
+		{"", "Unknown"},
+	}
+	for _, testCase := range cases {
+		if given := (NodeBalancerNode{Status: testCase.status}).StatusString(); given != testCase.expected {
+			t.Error("expected", testCase.expected, "given", given, "for", testCase.status)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	stackScriptListAction   = "stackscript.list"
+	availStackScriptsAction = "avail.stackscripts"
+)
+
+// StackScriptList returns slice of StackScripts, sorted by Label.
+// If ownerOnly is true, only the account's own StackScripts are returned (stackscript.list),
+// otherwise public StackScripts are returned (avail.stackscripts).
+func (c *Client) StackScriptList(ownerOnly bool) ([]StackScript, error) {
+	return c.StackScriptListContext(context.Background(), ownerOnly)
+}
+
+// StackScriptListContext is like StackScriptList, with ctx to cancel the API requests
+func (c *Client) StackScriptListContext(ctx context.Context, ownerOnly bool) ([]StackScript, error) {
+	method := availStackScriptsAction
+	if ownerOnly {
+		method = stackScriptListAction
+	}
+	var stackScripts sortedStackScripts
+	if err := c.doActionContext(ctx, method, nil, &stackScripts); err != nil {
+		return nil, err
+	}
+	sort.Sort(stackScripts)
+
+	return []StackScript(stackScripts), nil
+}
+
+// StackScript represents a StackScript as returned by the API
+type StackScript struct {
+	ID              int        `json:"STACKSCRIPTID"`
+	Label           string     `json:"LABEL"`
+	Description     string     `json:"DESCRIPTION"`
+	DistributionIDs []int      `json:"DISTRIBUTIONIDLIST"`
+	RevDT           LinodeTime `json:"REV_DT"`
+	Script          string     `json:"SCRIPT"`
+	Public          int        `json:"ISPUBLIC"`
+}
+
+// UnmarshalJSON parses DISTRIBUTIONIDLIST, which the API returns as a comma separated string
+func (s *StackScript) UnmarshalJSON(data []byte) error {
+	type stackScript StackScript // prevent recursion
+	aux := struct {
+		*stackScript
+		DistributionIDList string `json:"DISTRIBUTIONIDLIST"`
+	}{stackScript: (*stackScript)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	s.DistributionIDs = nil
+	for _, field := range strings.Split(aux.DistributionIDList, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.Atoi(field)
+		if err != nil {
+			return err
+		}
+		s.DistributionIDs = append(s.DistributionIDs, id)
+	}
+	return nil
+}
+
+// IsPublic returns true if the StackScript is public
+func (s StackScript) IsPublic() bool {
+	return s.Public == 1
+}
+
+// Sort StackScripts by Label
+type sortedStackScripts []StackScript
+
+func (sorted sortedStackScripts) Len() int {
+	return len(sorted)
+}
+func (sorted sortedStackScripts) Swap(i, j int) {
+	sorted[i], sorted[j] = sorted[j], sorted[i]
+}
+
+func (sorted sortedStackScripts) Less(i, j int) bool {
+	return sorted[i].Label < sorted[j].Label
+}
@@ -0,0 +1,25 @@
+package linode
+
+import "testing"
+
+func TestStackScriptList(t *testing.T) {
+	c, server := newTestServerClient(`[{"ERRORARRAY":[],"DATA":[{"STACKSCRIPTID":2,"LABEL":"web","DISTRIBUTIONIDLIST":"86, 78","ISPUBLIC":0},{"STACKSCRIPTID":1,"LABEL":"db","DISTRIBUTIONIDLIST":"","ISPUBLIC":1}],"ACTION":"stackscript.list"}]`)
+	defer server.Close()
+
+	stackScripts, err := c.StackScriptList(true)
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if len(stackScripts) != 2 {
+		t.Error("expected", 2, "given", len(stackScripts))
+		return
+	}
+	if stackScripts[0].Label != "db" || !stackScripts[0].IsPublic() || len(stackScripts[0].DistributionIDs) != 0 {
+		t.Error("unexpected stackscript", stackScripts[0])
+	}
+	ids := stackScripts[1].DistributionIDs
+	if len(ids) != 2 || ids[0] != 86 || ids[1] != 78 {
+		t.Error("expected", []int{86, 78}, "given", ids)
+	}
+}
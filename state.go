@@ -0,0 +1,83 @@
+package linode
+
+import "fmt"
+
+// LinodeState is the lifecycle state of a Linode, derived from its Status and pending jobs by DeriveState
+type LinodeState int
+
+// Lifecycle states of a Linode
+const (
+	StateUnknown LinodeState = iota
+	StateProvisioning
+	StateBooting
+	StateRunning
+	StateRebooting
+	StateStopping
+	StateStopped
+	StateResizing
+	StateFailed
+)
+
+func (s LinodeState) String() string {
+	switch s {
+	case StateUnknown:
+		return "Unknown"
+	case StateProvisioning:
+		return "Provisioning"
+	case StateBooting:
+		return "Booting"
+	case StateRunning:
+		return "Running"
+	case StateRebooting:
+		return "Rebooting"
+	case StateStopping:
+		return "Stopping"
+	case StateStopped:
+		return "Stopped"
+	case StateResizing:
+		return "Resizing"
+	case StateFailed:
+		return "Failed"
+	}
+	return fmt.Sprintf("LinodeState(%d)", int(s))
+}
+
+// jobStates maps the action of a pending job to the state of its Linode, in order of precedence
+var jobStates = []struct {
+	action string
+	state  LinodeState
+}{
+	{linodeCreateAction, StateProvisioning},
+	{linodeCloneAction, StateProvisioning},
+	{linodeResizeAction, StateResizing},
+	{linodeRebootAction, StateRebooting},
+	{linodeBootAction, StateBooting},
+	{linodeShutdownAction, StateStopping},
+}
+
+// DeriveState returns the lifecycle state of the Linode. Unfinished jobs of the Linode among pendingJobs,
+// as returned by JobList, take precedence over its Status; e.g. a running Linode with a pending
+// linode.reboot job is Rebooting.
+func DeriveState(l Linode, pendingJobs []Job) LinodeState {
+	for _, js := range jobStates {
+		for _, job := range pendingJobs {
+			if job.LinodeID == l.ID && !job.IsFinished() && job.Action == js.action {
+				return js.state
+			}
+		}
+	}
+
+	switch l.Status {
+	case -2:
+		return StateFailed
+	case -1, 0:
+		return StateProvisioning
+	case 1:
+		return StateRunning
+	case 2, 4:
+		return StateStopped
+	case 3:
+		return StateStopping
+	}
+	return StateUnknown
+}
@@ -0,0 +1,36 @@
+package linode
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeriveState(t *testing.T) {
+	finished := LinodeTime{time.Date(2009, 8, 17, 6, 18, 5, 0, time.UTC)}
+	cases := []struct {
+		status   int
+		jobs     []Job
+		expected LinodeState
+	}{
+		{1, nil, StateRunning},
+		{2, nil, StateStopped},
+		{-1, nil, StateProvisioning},
+		{-2, nil, StateFailed},
+		{3, nil, StateStopping},
+		{99, nil, StateUnknown},
+		{1, []Job{{LinodeID: 8098, Action: "linode.reboot"}}, StateRebooting},
+		{2, []Job{{LinodeID: 8098, Action: "linode.boot"}}, StateBooting},
+		{2, []Job{{LinodeID: 8098, Action: "linode.boot"}, {LinodeID: 8098, Action: "linode.resize"}}, StateResizing},
+		{0, []Job{{LinodeID: 8098, Action: "linode.create"}, {LinodeID: 8098, Action: "linode.boot"}}, StateProvisioning},
+		// finished jobs, jobs of other linodes and unknown actions are ignored
+		{1, []Job{{LinodeID: 8098, Action: "linode.reboot", HostFinishDT: finished}}, StateRunning},
+		{1, []Job{{LinodeID: 1, Action: "linode.shutdown"}}, StateRunning},
+		{1, []Job{{LinodeID: 8098, Action: "linode.disk.create"}}, StateRunning},
+	}
+	for _, testCase := range cases {
+		given := DeriveState(Linode{ID: 8098, Status: testCase.status}, testCase.jobs)
+		if given != testCase.expected {
+			t.Error("expected", testCase.expected, "given", given, "for", testCase.status, testCase.jobs)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package linode
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// linodeTimeFormat is the layout of the date fields returned by the API, e.g. "2009-08-17 06:17:55.0".
+// The trailing fractional seconds are accepted by time.Parse without being part of the layout.
+const linodeTimeFormat = "2006-01-02 15:04:05"
+
+// LinodeTime is a date field as returned by the API, such as CREATE_DT. The API does not include a time zone,
+// so the time is parsed as UTC. An empty string is parsed as the zero time.
+type LinodeTime struct {
+	time.Time
+}
+
+// UnmarshalJSON parses the API date format
+func (t *LinodeTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := time.ParseInLocation(linodeTimeFormat, s, time.UTC)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// MarshalJSON formats t in the API date format, and the zero time as an empty string
+func (t LinodeTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return json.Marshal("")
+	}
+	return json.Marshal(t.Format(linodeTimeFormat + ".0"))
+}
@@ -0,0 +1,46 @@
+package linode
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestLinodeTimeUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		data     string
+		expected time.Time
+	}{
+		{`"2009-08-17 06:17:55.0"`, time.Date(2009, 8, 17, 6, 17, 55, 0, time.UTC)},
+		{`"2001-09-06 00:00:00.0"`, time.Date(2001, 9, 6, 0, 0, 0, 0, time.UTC)},
+		{`""`, time.Time{}},
+	}
+	for _, testCase := range cases {
+		var given LinodeTime
+		if err := json.Unmarshal([]byte(testCase.data), &given); err != nil {
+			t.Error("unexpected error", err)
+			continue
+		}
+		if !given.Equal(testCase.expected) {
+			t.Error("expected", testCase.expected, "given", given)
+		}
+		data, err := json.Marshal(given)
+		if err != nil {
+			t.Error("unexpected error", err)
+		} else if string(data) != testCase.data {
+			t.Error("expected", testCase.data, "given", string(data))
+		}
+	}
+
+	var account Account
+	if err := json.Unmarshal([]byte(`{"ACTIVE_SINCE":"2001-09-06 00:00:00.0"}`), &account); err != nil {
+		t.Error("unexpected error", err)
+	} else if expected := time.Date(2001, 9, 6, 0, 0, 0, 0, time.UTC); !account.ActiveSince.Equal(expected) {
+		t.Error("expected", expected, "given", account.ActiveSince)
+	}
+
+	var invalid LinodeTime
+	if err := json.Unmarshal([]byte(`"yesterday"`), &invalid); err == nil {
+		t.Error("expected error")
+	}
+}
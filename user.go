@@ -0,0 +1,57 @@
+package linode
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	userGetAPIKeyAction = "user.getapikey"
+)
+
+// APIKeyParams holds the optional params of GetAPIKeyWithParams
+type APIKeyParams struct {
+	Label   string // label of the key
+	Expires int    // number of hours the key remains valid; 0 means the API default
+}
+
+// GetAPIKey exchanges a username and password for an API key.
+// It is the only action which does not require an API key; opts configure the client used to make the request.
+// The credentials are sent as a form POST, and are redacted from logs and metrics.
+func GetAPIKey(username, password string, opts ...Option) (string, error) {
+	return GetAPIKeyContext(context.Background(), username, password, opts...)
+}
+
+// GetAPIKeyContext is like GetAPIKey, with ctx to cancel the API requests
+func GetAPIKeyContext(ctx context.Context, username, password string, opts ...Option) (string, error) {
+	return GetAPIKeyWithParamsContext(ctx, username, password, APIKeyParams{}, opts...)
+}
+
+// GetAPIKeyWithParams is like GetAPIKey, but also passes the label and expiration of the key to the API
+func GetAPIKeyWithParams(username, password string, keyParams APIKeyParams, opts ...Option) (string, error) {
+	return GetAPIKeyWithParamsContext(context.Background(), username, password, keyParams, opts...)
+}
+
+// GetAPIKeyWithParamsContext is like GetAPIKeyWithParams, with ctx to cancel the API requests
+func GetAPIKeyWithParamsContext(ctx context.Context, username, password string, keyParams APIKeyParams, opts ...Option) (string, error) {
+	params := map[string]string{
+		"username": username,
+		"password": password,
+	}
+	if keyParams.Label != "" {
+		params["label"] = keyParams.Label
+	}
+	if keyParams.Expires != 0 {
+		params["expires"] = strconv.Itoa(keyParams.Expires)
+	}
+	var data struct {
+		APIKey string `json:"API_KEY"`
+	}
+	c := NewClient("", opts...)
+	// send the credentials in the body of a POST, rather than in the URL
+	c.postOnly = true
+	if err := c.doActionContext(ctx, userGetAPIKeyAction, params, &data); err != nil {
+		return "", err
+	}
+	return data.APIKey, nil
+}
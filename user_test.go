@@ -0,0 +1,90 @@
+package linode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetAPIKey(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.RawQuery != "" {
+			t.Error("expected credentials in a POST body, given", r.Method, r.URL)
+		}
+		r.ParseForm()
+		query = r.PostForm
+		writeJSON(w, `[{"ERRORARRAY":[],"DATA":{"USERNAME":"user","API_KEY":"newkey"},"ACTION":"user.getapikey"}]`)
+	}))
+	defer server.Close()
+
+	key, err := GetAPIKeyWithParams("user", "pass", APIKeyParams{Label: "cli", Expires: 2}, WithBaseURL(server.URL))
+	if err != nil {
+		t.Error("unexpected error", err)
+		return
+	}
+	if key != "newkey" {
+		t.Error("expected", "newkey", "given", key)
+	}
+	if _, ok := query["api_key"]; ok {
+		t.Error("unexpected api_key param")
+	}
+	expected := `[{"api_action":"user.getapikey","expires":"2","label":"cli","password":"pass","username":"user"}]`
+	if given := query["api_requestArray"][0]; given != expected {
+		t.Error("expected", expected, "given", given)
+	}
+}
+
+func TestGetAPIKeyContextCanceled(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":{"USERNAME":"bob","API_KEY":"newkey"},"ACTION":"user.getapikey"}]`)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := GetAPIKeyContext(ctx, "bob", "secret", WithBaseURL(server.URL)); !errors.Is(err, context.Canceled) {
+		t.Error("expected", context.Canceled, "given", err)
+	}
+}
+
+func TestGetAPIKeyRedactsPassword(t *testing.T) {
+	server := newTestServer(200, `[{"ERRORARRAY":[],"DATA":{"USERNAME":"bob","API_KEY":"newkey"},"ACTION":"user.getapikey"}]`)
+	defer server.Close()
+
+	const password = "hunter2secret"
+	logger := &testLogger{}
+	var metricsURLs []string
+	metrics := func(url string, status int, dur time.Duration, err error) {
+		metricsURLs = append(metricsURLs, url)
+	}
+	if _, err := GetAPIKey("bob", password, WithBaseURL(server.URL), WithLogger(logger), WithMetrics(metrics), WithPrettyRequestArray()); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if len(logger.lines) == 0 || len(metricsURLs) == 0 {
+		t.Fatal("expected logs and metrics, given", logger.lines, metricsURLs)
+	}
+	for _, line := range append(logger.lines, metricsURLs...) {
+		if strings.Contains(line, password) {
+			t.Error("expected password to be redacted, given", line)
+		}
+	}
+	if joined := strings.Join(logger.lines, "\n"); !strings.Contains(joined, `"password": "***"`) {
+		t.Error("expected the pretty api_requestArray to be logged with the password redacted, given", joined)
+	}
+
+	r := NewClient("").NewRequest().AddAction("user.getapikey", map[string]string{"username": "bob", "password": password})
+	if s := fmt.Sprint(r); strings.Contains(s, password) {
+		t.Error("expected password to be redacted, given", s)
+	}
+	urls, err := r.RedactedURLs()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if strings.Contains(urls[0], password) || !strings.Contains(urls[0], "***") {
+		t.Error("expected password to be redacted, given", urls[0])
+	}
+}